@@ -13,3 +13,31 @@ type StringCloser interface {
 	binding.String
 	io.Closer
 }
+
+// StringListCloser is an extension of the StringList interface that allows resources to be freed
+// using the standard `Close()` method.
+type StringListCloser interface {
+	binding.StringList
+	io.Closer
+}
+
+// BoolCloser is an extension of the Bool interface that allows resources to be freed
+// using the standard `Close()` method.
+type BoolCloser interface {
+	binding.Bool
+	io.Closer
+}
+
+// FloatCloser is an extension of the Float interface that allows resources to be freed
+// using the standard `Close()` method.
+type FloatCloser interface {
+	binding.Float
+	io.Closer
+}
+
+// IntCloser is an extension of the Int interface that allows resources to be freed
+// using the standard `Close()` method.
+type IntCloser interface {
+	binding.Int
+	io.Closer
+}
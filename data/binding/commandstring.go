@@ -0,0 +1,188 @@
+package binding
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// commandCloseTimeout is how long Close waits for the command to exit once killed.
+const commandCloseTimeout = 5 * time.Second
+
+var errCommandTimeout = errors.New("timed out waiting for the command to exit")
+
+// CommandOutputString is a `StringCloser` bound to the output of a command, that also reports
+// the exit status of the command.
+type CommandOutputString interface {
+	StringCloser
+
+	// ExitStatus returns an `Int` binding set to the exit code of the command once it has exited.
+	// It stays at -1 while the command is running, and if it was terminated by a signal.
+	ExitStatus() binding.Int
+}
+
+// CommandOutputList is a `StringListCloser` bound to the latest lines output by a command, that
+// also reports the exit status of the command.
+type CommandOutputList interface {
+	StringListCloser
+
+	// ExitStatus returns an `Int` binding set to the exit code of the command once it has exited.
+	// It stays at -1 while the command is running, and if it was terminated by a signal.
+	ExitStatus() binding.Int
+}
+
+type commandOutput struct {
+	cmd    *exec.Cmd
+	stdout io.ReadCloser
+	status binding.Int
+	done   chan struct{}
+
+	lock   sync.RWMutex
+	prev   error
+	closed bool
+}
+
+type commandOutputString struct {
+	binding.String
+	*commandOutput
+}
+
+type commandOutputList struct {
+	*lineList
+	*commandOutput
+}
+
+// NewCommandOutputString starts the command `cmd` and returns a `String` binding set to the latest
+// line written by the command on its standard output.
+// You should also call `Close()` on the binding once you are done, which kills the command if it
+// is still running.
+func NewCommandOutputString(cmd *exec.Cmd) (CommandOutputString, error) {
+	ret := &commandOutputString{String: binding.NewString()}
+
+	out, err := startCommandOutput(cmd, lastLine(ret.String))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.commandOutput = out
+	return ret, nil
+}
+
+// NewCommandOutputList starts the command `cmd` and returns a `StringList` binding containing the
+// last `lines` lines written by the command on its standard output, or all of them if `lines` is 0.
+// An error is returned if `lines` is negative.
+// You should also call `Close()` on the binding once you are done, which kills the command if it
+// is still running.
+func NewCommandOutputList(cmd *exec.Cmd, lines int) (CommandOutputList, error) {
+	if lines < 0 {
+		return nil, errNegativeLines
+	}
+	ret := &commandOutputList{lineList: newLineList(lines)}
+
+	out, err := startCommandOutput(cmd, ret.add)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.commandOutput = out
+	return ret, nil
+}
+
+func startCommandOutput(cmd *exec.Cmd, received func([]string)) (*commandOutput, error) {
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	out := &commandOutput{cmd: cmd, stdout: stdout, status: binding.NewInt(), done: make(chan struct{})}
+	out.status.Set(-1)
+
+	go func() {
+		defer close(out.done)
+
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			received([]string{scanner.Text()})
+		}
+		if err := scanner.Err(); err != nil && !out.isClosed() {
+			out.setError(err)
+			_, _ = io.Copy(ioutil.Discard, stdout) // the command must not block writing its output
+		}
+
+		_ = cmd.Wait() // the exit code is reported by the status binding
+		out.status.Set(cmd.ProcessState.ExitCode())
+	}()
+
+	return out, nil
+}
+
+func (c *commandOutput) ExitStatus() binding.Int {
+	return c.status
+}
+
+func (c *commandOutput) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+	}
+
+	// the output is closed too, as the processes started by the command may still write to it
+	c.lock.Lock()
+	c.closed = true
+	c.lock.Unlock()
+	_ = c.cmd.Process.Kill() // fails only if the command already exited
+	_ = c.stdout.Close()
+
+	select {
+	case <-c.done:
+		return nil
+	case <-time.After(commandCloseTimeout):
+		return errCommandTimeout
+	}
+}
+
+func (c *commandOutput) setError(err error) {
+	c.lock.Lock()
+	c.prev = err
+	c.lock.Unlock()
+}
+
+func (c *commandOutput) isClosed() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.closed
+}
+
+func (c *commandOutput) err() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.prev
+}
+
+func (s *commandOutputString) Get() (string, error) {
+	if err := s.err(); err != nil {
+		return "", err
+	}
+
+	return s.String.Get()
+}
+
+func (l *commandOutputList) Get() ([]string, error) {
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+
+	return l.lineList.Get()
+}
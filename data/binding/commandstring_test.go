@@ -0,0 +1,143 @@
+package binding
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// helperCommand returns a command running this test binary as a fake process, see TestHelperProcess.
+func helperCommand(mode string) *exec.Cmd {
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess")
+	cmd.Env = append(os.Environ(), "FYNE_X_HELPER_PROCESS="+mode)
+	return cmd
+}
+
+func TestHelperProcess(t *testing.T) {
+	switch os.Getenv("FYNE_X_HELPER_PROCESS") {
+	case "":
+		return
+	case "lines":
+		fmt.Println("one")
+		fmt.Println("two")
+		fmt.Println("three")
+		os.Exit(3)
+	case "long":
+		fmt.Println(strings.Repeat("x", 200000))
+		fmt.Println("after")
+		os.Exit(4)
+	case "ticks": // until the output is closed
+		for i := 0; i < 1200; i++ {
+			fmt.Println("waiting")
+			time.Sleep(50 * time.Millisecond)
+		}
+	case "sleep":
+		fmt.Println("waiting")
+		time.Sleep(time.Minute)
+	}
+	os.Exit(0)
+}
+
+func TestCommandOutputString(t *testing.T) {
+	s, err := NewCommandOutputString(helperCommand("lines"))
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, func() bool {
+		status, _ := s.ExitStatus().Get()
+		return status == 3
+	})
+	v, err := s.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "three", v)
+
+	assert.NoError(t, s.Close())
+}
+
+func TestCommandOutputList(t *testing.T) {
+	l, err := NewCommandOutputList(helperCommand("lines"), 2)
+	assert.NoError(t, err)
+	defer l.Close()
+
+	waitFor(t, func() bool {
+		status, _ := l.ExitStatus().Get()
+		return status == 3
+	})
+	lines, err := l.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"two", "three"}, lines)
+}
+
+func TestCommandOutputList_Unlimited(t *testing.T) {
+	_, err := NewCommandOutputList(helperCommand("lines"), -1)
+	assert.Equal(t, errNegativeLines, err)
+
+	l, err := NewCommandOutputList(helperCommand("lines"), 0)
+	assert.NoError(t, err)
+	defer l.Close()
+
+	waitFor(t, func() bool {
+		status, _ := l.ExitStatus().Get()
+		return status == 3
+	})
+	lines, err := l.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, lines)
+}
+
+func TestCommandOutputString_Close(t *testing.T) {
+	s, err := NewCommandOutputString(helperCommand("sleep"))
+	assert.NoError(t, err)
+
+	waitFor(t, stringEquals(s, "waiting"))
+	status, _ := s.ExitStatus().Get()
+	assert.Equal(t, -1, status)
+
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+}
+
+func TestCommandOutputString_ClosePipeline(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("no shell to run a pipeline")
+	}
+	// the helper and cat keep the output open once the shell is killed, until it is closed
+	cmd := exec.Command("sh", "-c", `"$0" -test.run=TestHelperProcess | cat`, os.Args[0])
+	cmd.Env = append(os.Environ(), "FYNE_X_HELPER_PROCESS=ticks")
+	s, err := NewCommandOutputString(cmd)
+	assert.NoError(t, err)
+
+	waitFor(t, stringEquals(s, "waiting"))
+	closed := make(chan error)
+	go func() { closed <- s.Close() }()
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(commandCloseTimeout + time.Second):
+		t.Fatal("Close did not return")
+	}
+}
+
+func TestCommandOutputString_LineTooLong(t *testing.T) {
+	s, err := NewCommandOutputString(helperCommand("long"))
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, func() bool {
+		status, _ := s.ExitStatus().Get()
+		return status == 4
+	})
+	_, err = s.Get()
+	assert.Equal(t, bufio.ErrTooLong, err)
+}
+
+func TestCommandOutputString_NotFound(t *testing.T) {
+	_, err := NewCommandOutputString(exec.Command("fyne-x-command-that-does-not-exist"))
+	assert.Error(t, err)
+}
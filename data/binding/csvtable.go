@@ -0,0 +1,490 @@
+package binding
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/storage"
+)
+
+// CSVColumnType is the type of the values of a column, as inferred from the content of a CSV table.
+type CSVColumnType int
+
+const (
+	// CSVString is a column containing text.
+	CSVString CSVColumnType = iota
+	// CSVInt is a column containing only integers.
+	CSVInt
+	// CSVFloat is a column containing only numbers.
+	CSVFloat
+	// CSVBool is a column containing only booleans, as understood by `strconv.ParseBool`.
+	CSVBool
+)
+
+// CSVHeader selects if the first row of a CSV table is a header.
+type CSVHeader int
+
+const (
+	// CSVHeaderAuto detects a header when the first row does not match the type of its columns.
+	CSVHeaderAuto CSVHeader = iota
+	// CSVHeaderPresent always uses the first row as header.
+	CSVHeaderPresent
+	// CSVHeaderAbsent never uses the first row as header.
+	CSVHeaderAbsent
+)
+
+// CSVOptions configures how a CSV table is parsed.
+type CSVOptions struct {
+	// Comma is the field delimiter, a comma if zero.
+	Comma rune
+	// Header selects if the first row is a header, it is detected by default.
+	Header CSVHeader
+}
+
+// CSVTable supports binding tabular data read from CSV. Listeners are notified when any cell changes.
+type CSVTable interface {
+	binding.DataItem
+
+	// Length returns the number of rows, header excluded, and of columns. It can be used as the
+	// length callback of a `widget.Table`.
+	Length() (int, int)
+	// Header returns the names of the columns, empty if the table has no header.
+	Header() []string
+	// ColumnType returns the type inferred from the values of the column.
+	ColumnType(col int) CSVColumnType
+
+	GetCell(row, col int) (binding.String, error)
+	GetCellFloat(row, col int) (binding.Float, error)
+	GetCellInt(row, col int) (binding.Int, error)
+	GetCellBool(row, col int) (binding.Bool, error)
+
+	// Write writes the table, header included, as CSV.
+	Write(w io.Writer) error
+}
+
+type csvTable struct {
+	uri   fyne.URI
+	comma rune
+
+	lock   sync.RWMutex
+	header []string
+	rows   [][]string
+	types  []CSVColumnType
+	items  map[csvKey]csvItem
+
+	self    binding.Int // counts the changes to notify listeners of the table
+	changes int64
+}
+
+// csvItem is implemented by all the bindings to a cell.
+type csvItem interface {
+	binding.DataItem
+	reload()
+}
+
+type csvKey struct {
+	row, col int
+	typ      CSVColumnType
+}
+
+type csvCell struct {
+	table    *csvTable
+	row, col int
+}
+
+type csvString struct {
+	binding.String
+	csvCell
+}
+
+type csvFloat struct {
+	binding.Float
+	csvCell
+}
+
+type csvInt struct {
+	binding.Int
+	csvCell
+}
+
+type csvBool struct {
+	binding.Bool
+	csvCell
+}
+
+var (
+	errCellOutOfRange = errors.New("cell out of range")
+)
+
+// NewCSVTable returns a data binding to the CSV table read from `r`. As there is no destination to
+// write back to, changes made to the cells are only kept in memory, use `Write` to save them.
+// `opts` can be nil to use the default options.
+func NewCSVTable(r io.Reader, opts *CSVOptions) (CSVTable, error) {
+	return newCSVTable(r, nil, opts)
+}
+
+// NewCSVTableFromURI returns a data binding to the CSV table read from `uri`. Changes made to the
+// cells are written back to `uri`, and `Set` returns an error if that failed.
+// `opts` can be nil to use the default options.
+func NewCSVTableFromURI(uri fyne.URI, opts *CSVOptions) (CSVTable, error) {
+	r, err := storage.Reader(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return newCSVTable(r, uri, opts)
+}
+
+func newCSVTable(r io.Reader, uri fyne.URI, opts *CSVOptions) (*csvTable, error) {
+	if opts == nil {
+		opts = &CSVOptions{}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &csvTable{uri: uri, comma: reader.Comma, self: binding.NewInt(), items: make(map[csvKey]csvItem)}
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	for i, row := range rows { // pad irregular rows so that all cells exist
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		rows[i] = row
+	}
+
+	t.rows = rows
+	t.types = inferCSVTypes(rows, columns)
+	if len(rows) > 0 && (opts.Header == CSVHeaderPresent || opts.Header == CSVHeaderAuto && isCSVHeader(rows)) {
+		t.header, t.rows = rows[0], rows[1:]
+		t.types = inferCSVTypes(t.rows, columns)
+	}
+	return t, nil
+}
+
+// inferCSVTypes returns the most specific type matching all the non empty cells of each column.
+func inferCSVTypes(rows [][]string, columns int) []CSVColumnType {
+	types := make([]CSVColumnType, columns)
+	for col := range types {
+		types[col] = CSVString
+		for _, typ := range []CSVColumnType{CSVInt, CSVFloat, CSVBool} {
+			if matchesCSVColumn(rows, col, typ) {
+				types[col] = typ
+				break
+			}
+		}
+	}
+	return types
+}
+
+func matchesCSVColumn(rows [][]string, col int, typ CSVColumnType) bool {
+	empty := true
+	for _, row := range rows {
+		if row[col] == "" {
+			continue
+		}
+
+		empty = false
+		if !matchesCSVType(row[col], typ) {
+			return false
+		}
+	}
+	return !empty
+}
+
+func matchesCSVType(cell string, typ CSVColumnType) bool {
+	var err error
+	switch typ {
+	case CSVInt:
+		_, err = strconv.Atoi(cell)
+	case CSVFloat:
+		_, err = strconv.ParseFloat(cell, 64)
+	case CSVBool:
+		_, err = strconv.ParseBool(cell)
+	}
+	return err == nil
+}
+
+// isCSVHeader reports if the first row looks like a header: one of its cells does not match the
+// type of the values below it.
+func isCSVHeader(rows [][]string) bool {
+	if len(rows) < 2 {
+		return false
+	}
+
+	for col, typ := range inferCSVTypes(rows[1:], len(rows[0])) {
+		if rows[0][col] != "" && !matchesCSVType(rows[0][col], typ) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *csvTable) AddListener(listener binding.DataListener) {
+	t.self.AddListener(listener)
+}
+
+func (t *csvTable) RemoveListener(listener binding.DataListener) {
+	t.self.RemoveListener(listener)
+}
+
+func (t *csvTable) Length() (int, int) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.rows), len(t.types)
+}
+
+func (t *csvTable) Header() []string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return append([]string{}, t.header...)
+}
+
+func (t *csvTable) ColumnType(col int) CSVColumnType {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if col < 0 || col >= len(t.types) {
+		return CSVString
+	}
+	return t.types[col]
+}
+
+func (t *csvTable) GetCell(row, col int) (binding.String, error) {
+	cell, err := t.cell(row, col)
+	if err != nil {
+		return nil, err
+	}
+
+	item := t.item(csvKey{row, col, CSVString}, func() csvItem {
+		return &csvString{String: binding.NewString(), csvCell: cell}
+	})
+	return item.(binding.String), nil
+}
+
+func (t *csvTable) GetCellFloat(row, col int) (binding.Float, error) {
+	cell, err := t.cell(row, col)
+	if err != nil {
+		return nil, err
+	}
+	if typ := t.ColumnType(col); typ != CSVFloat && typ != CSVInt {
+		return nil, errWrongType
+	}
+
+	item := t.item(csvKey{row, col, CSVFloat}, func() csvItem {
+		return &csvFloat{Float: binding.NewFloat(), csvCell: cell}
+	})
+	return item.(binding.Float), nil
+}
+
+func (t *csvTable) GetCellInt(row, col int) (binding.Int, error) {
+	cell, err := t.cell(row, col)
+	if err != nil {
+		return nil, err
+	}
+	if t.ColumnType(col) != CSVInt {
+		return nil, errWrongType
+	}
+
+	item := t.item(csvKey{row, col, CSVInt}, func() csvItem {
+		return &csvInt{Int: binding.NewInt(), csvCell: cell}
+	})
+	return item.(binding.Int), nil
+}
+
+func (t *csvTable) GetCellBool(row, col int) (binding.Bool, error) {
+	cell, err := t.cell(row, col)
+	if err != nil {
+		return nil, err
+	}
+	if t.ColumnType(col) != CSVBool {
+		return nil, errWrongType
+	}
+
+	item := t.item(csvKey{row, col, CSVBool}, func() csvItem {
+		return &csvBool{Bool: binding.NewBool(), csvCell: cell}
+	})
+	return item.(binding.Bool), nil
+}
+
+func (t *csvTable) Write(w io.Writer) error {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.write(w)
+}
+
+func (t *csvTable) write(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = t.comma
+	if t.header != nil {
+		if err := writer.Write(t.header); err != nil {
+			return err
+		}
+	}
+	if err := writer.WriteAll(t.rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+func (t *csvTable) cell(row, col int) (csvCell, error) {
+	rows, cols := t.Length()
+	if row < 0 || row >= rows || col < 0 || col >= cols {
+		return csvCell{}, errCellOutOfRange
+	}
+
+	return csvCell{table: t, row: row, col: col}, nil
+}
+
+// item returns the binding of a cell of the given type, creating it on first access.
+func (t *csvTable) item(key csvKey, create func() csvItem) csvItem {
+	t.lock.Lock()
+	item, ok := t.items[key]
+	if !ok {
+		item = create()
+		t.items[key] = item
+	}
+	t.lock.Unlock()
+
+	if !ok {
+		item.reload()
+	}
+	return item
+}
+
+// set changes the value of a cell, writing the table back to its URI if there is one,
+// then updates the bindings of the cell.
+func (t *csvTable) set(row, col int, val string) error {
+	t.lock.Lock()
+	if t.rows[row][col] == val {
+		t.lock.Unlock()
+		return nil
+	}
+
+	t.rows[row][col] = val
+	err := t.save()
+	var items []csvItem
+	for _, typ := range []CSVColumnType{CSVString, CSVInt, CSVFloat, CSVBool} {
+		if item, ok := t.items[csvKey{row, col, typ}]; ok {
+			items = append(items, item)
+		}
+	}
+	t.lock.Unlock()
+
+	for _, item := range items {
+		item.reload()
+	}
+	_ = t.self.Set(int(atomic.AddInt64(&t.changes, 1))) // we control self, Set will not error
+	return err
+}
+
+func (t *csvTable) save() error {
+	if t.uri == nil {
+		return nil
+	}
+
+	w, err := storage.Writer(t.uri)
+	if err != nil {
+		return err
+	}
+
+	err = t.write(w)
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (c *csvCell) get() string {
+	c.table.lock.RLock()
+	defer c.table.lock.RUnlock()
+
+	return c.table.rows[c.row][c.col]
+}
+
+func (s *csvString) Get() (string, error) {
+	return s.get(), nil
+}
+
+func (s *csvString) Set(val string) error {
+	return s.table.set(s.row, s.col, val)
+}
+
+func (s *csvString) reload() {
+	_ = s.String.Set(s.get()) // we control s, Set will not error
+}
+
+func (f *csvFloat) Get() (float64, error) {
+	if val := f.get(); val != "" {
+		return strconv.ParseFloat(val, 64)
+	}
+	return 0, nil
+}
+
+func (f *csvFloat) Set(val float64) error {
+	if f.table.ColumnType(f.col) == CSVInt && val != float64(int(val)) {
+		return errWrongType
+	}
+	return f.table.set(f.row, f.col, strconv.FormatFloat(val, 'f', -1, 64))
+}
+
+func (f *csvFloat) reload() {
+	if val, err := f.Get(); err == nil {
+		_ = f.Float.Set(val) // we control f, Set will not error
+	}
+}
+
+func (i *csvInt) Get() (int, error) {
+	if val := i.get(); val != "" {
+		return strconv.Atoi(val)
+	}
+	return 0, nil
+}
+
+func (i *csvInt) Set(val int) error {
+	return i.table.set(i.row, i.col, strconv.Itoa(val))
+}
+
+func (i *csvInt) reload() {
+	if val, err := i.Get(); err == nil {
+		_ = i.Int.Set(val) // we control i, Set will not error
+	}
+}
+
+func (b *csvBool) Get() (bool, error) {
+	if val := b.get(); val != "" {
+		return strconv.ParseBool(val)
+	}
+	return false, nil
+}
+
+func (b *csvBool) Set(val bool) error {
+	return b.table.set(b.row, b.col, strconv.FormatBool(val))
+}
+
+func (b *csvBool) reload() {
+	if val, err := b.Get(); err == nil {
+		_ = b.Bool.Set(val) // we control b, Set will not error
+	}
+}
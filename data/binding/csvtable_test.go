@@ -0,0 +1,118 @@
+package binding
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCSV = `name,age,height,admin
+Ann,30,1.62,true
+Bob,,1.8,false
+`
+
+func TestNewCSVTable(t *testing.T) {
+	table, err := NewCSVTable(strings.NewReader(testCSV), nil)
+	assert.NoError(t, err)
+
+	rows, cols := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 4, cols)
+	assert.Equal(t, []string{"name", "age", "height", "admin"}, table.Header())
+	assert.Equal(t, CSVString, table.ColumnType(0))
+	assert.Equal(t, CSVInt, table.ColumnType(1))
+	assert.Equal(t, CSVFloat, table.ColumnType(2))
+	assert.Equal(t, CSVBool, table.ColumnType(3))
+
+	_, err = table.GetCell(2, 0)
+	assert.Error(t, err)
+	_, err = table.GetCellInt(0, 0)
+	assert.Error(t, err)
+}
+
+func TestNewCSVTable_Header(t *testing.T) {
+	table, err := NewCSVTable(strings.NewReader("a,b\nc,d\n"), nil)
+	assert.NoError(t, err)
+	rows, _ := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Empty(t, table.Header())
+
+	table, err = NewCSVTable(strings.NewReader("a,b\nc,d\n"), &CSVOptions{Header: CSVHeaderPresent})
+	assert.NoError(t, err)
+	rows, _ = table.Length()
+	assert.Equal(t, 1, rows)
+	assert.Equal(t, []string{"a", "b"}, table.Header())
+
+	table, err = NewCSVTable(strings.NewReader("1;2\n3\n"), &CSVOptions{Comma: ';'})
+	assert.NoError(t, err)
+	rows, cols := table.Length()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+	cell, _ := table.GetCell(1, 1)
+	v, _ := cell.Get()
+	assert.Equal(t, "", v)
+}
+
+func TestCSVTable_Cells(t *testing.T) {
+	table, err := NewCSVTable(strings.NewReader(testCSV), nil)
+	assert.NoError(t, err)
+
+	name, err := table.GetCell(0, 0)
+	assert.NoError(t, err)
+	v, _ := name.Get()
+	assert.Equal(t, "Ann", v)
+	same, _ := table.GetCell(0, 0)
+	assert.Equal(t, name, same)
+
+	age, err := table.GetCellInt(1, 1)
+	assert.NoError(t, err)
+	i, err := age.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, i)
+
+	ageText, _ := table.GetCell(1, 1)
+	assert.NoError(t, age.Set(25))
+	waitFor(t, stringEquals(ageText, "25"))
+
+	height, err := table.GetCellFloat(0, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, height.Set(1.65))
+
+	admin, err := table.GetCellBool(1, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, admin.Set(true))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, table.Write(buf))
+	assert.Equal(t, "name,age,height,admin\nAnn,30,1.65,true\nBob,25,1.8,true\n", buf.String())
+}
+
+func TestNewCSVTableFromURI(t *testing.T) {
+	a := test.NewApp()
+	defer a.Quit()
+
+	dir, err := ioutil.TempDir("", "csv")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "people.csv")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(testCSV), 0644))
+
+	table, err := NewCSVTableFromURI(storage.NewFileURI(path), nil)
+	assert.NoError(t, err)
+
+	name, err := table.GetCell(1, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, name.Set("Bill"))
+
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "name,age,height,admin\nAnn,30,1.62,true\nBill,,1.8,false\n", string(content))
+}
@@ -0,0 +1,225 @@
+package binding
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+var (
+	errReadOnly = errors.New("binding is read only")
+)
+
+// derivedString is a `String` binding which value comes from other data items.
+// It listens to its sources until it is closed.
+type derivedString struct {
+	sources  []binding.DataItem
+	listener binding.DataListener
+	write    func(string) error
+	self     binding.Int // counts the changes to notify listeners of the value and of the errors
+
+	lock    sync.RWMutex
+	val     string
+	prev    error
+	changes int
+	timer   *time.Timer
+	closed  bool
+}
+
+// Map returns a `String` binding set to the result of `fn` applied to the value of `src` each time
+// it changes. If `fn` returns an error, `Get` returns that error until the next successful update.
+// The resulting binding is read only. You should call `Close()` on it once you are done to stop
+// listening to `src`.
+func Map(src binding.String, fn func(string) (string, error)) StringCloser {
+	ret := newDerivedString(nil, src)
+	ret.listen(func() {
+		val, err := src.Get()
+		if err == nil {
+			val, err = fn(val)
+		}
+		ret.update(val, err)
+	})
+
+	return ret
+}
+
+// Combine returns a `String` binding set to the result of `fn` applied to the values of `a` and `b`
+// each time one of them changes. If `fn` returns an error, `Get` returns that error until the next
+// successful update. The resulting binding is read only. You should call `Close()` on it once you
+// are done to stop listening to `a` and `b`.
+func Combine(a, b binding.String, fn func(string, string) (string, error)) StringCloser {
+	ret := newDerivedString(nil, a, b)
+	ret.listen(func() {
+		valA, err := a.Get()
+		if err != nil {
+			ret.update("", err)
+			return
+		}
+		valB, err := b.Get()
+		if err == nil {
+			valB, err = fn(valA, valB)
+		}
+		ret.update(valB, err)
+	})
+
+	return ret
+}
+
+// Debounce returns a `String` binding following the value of `src`, that is only updated once `src`
+// did not change for the `delay`. It is useful to avoid costly updates while the user is typing,
+// or to wait for a chatty source to settle. Setting the resulting binding sets `src`.
+// You should call `Close()` on it once you are done to stop listening to `src`.
+func Debounce(src binding.String, delay time.Duration) StringCloser {
+	ret := newDerivedString(src.Set, src)
+	ret.update(src.Get())
+	ret.listen(func() {
+		ret.lock.Lock()
+		defer ret.lock.Unlock()
+
+		if ret.closed {
+			return
+		}
+		if ret.timer != nil {
+			ret.timer.Stop()
+		}
+		ret.timer = time.AfterFunc(delay, func() {
+			ret.update(src.Get())
+		})
+	})
+
+	return ret
+}
+
+// Throttle returns a `String` binding following the value of `src`, that is updated at most once
+// for each `interval`. The latest value of `src` is always delivered at the end of an interval.
+// It is useful to rate limit a chatty source, such as MQTT or WebSocket feeds, before it reaches
+// the user interface. Setting the resulting binding sets `src`.
+// You should call `Close()` on it once you are done to stop listening to `src`.
+func Throttle(src binding.String, interval time.Duration) StringCloser {
+	ret := newDerivedString(src.Set, src)
+	pending := false
+
+	var endInterval func()
+	endInterval = func() {
+		ret.lock.Lock()
+		ret.timer = nil
+		if !pending || ret.closed {
+			ret.lock.Unlock()
+			return
+		}
+
+		pending = false
+		ret.timer = time.AfterFunc(interval, endInterval)
+		ret.lock.Unlock()
+		ret.update(src.Get())
+	}
+
+	ret.listen(func() {
+		ret.lock.Lock()
+		if ret.closed {
+			ret.lock.Unlock()
+			return
+		}
+		if ret.timer != nil { // wait for the end of the current interval
+			pending = true
+			ret.lock.Unlock()
+			return
+		}
+
+		ret.timer = time.AfterFunc(interval, endInterval)
+		ret.lock.Unlock()
+		ret.update(src.Get())
+	})
+
+	return ret
+}
+
+// Distinct returns a `String` binding following the value of `src`, that only notifies its listeners
+// when the value actually changed. The bindings of Fyne and of this package already do so: it is
+// useful with other implementations of `String` triggering their listeners for every message
+// received, even when the content is the same. Setting the resulting binding sets `src`.
+// You should call `Close()` on it once you are done to stop listening to `src`.
+func Distinct(src binding.String) StringCloser {
+	ret := newDerivedString(src.Set, src)
+	ret.listen(func() {
+		ret.update(src.Get())
+	})
+
+	return ret
+}
+
+func newDerivedString(write func(string) error, sources ...binding.DataItem) *derivedString {
+	return &derivedString{sources: sources, write: write, self: binding.NewInt()}
+}
+
+func (d *derivedString) listen(fn func()) {
+	d.listener = binding.NewDataListener(fn)
+	for _, src := range d.sources {
+		src.AddListener(d.listener)
+	}
+}
+
+// update sets the value of the binding, unless it has been closed, and notifies the listeners
+// if the value or the error has changed. The value is kept while the error is set.
+func (d *derivedString) update(val string, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.closed {
+		return
+	}
+
+	changed := err != d.prev || err == nil && val != d.val
+	d.prev = err
+	if err == nil {
+		d.val = val
+	}
+	if changed {
+		d.changes++
+		_ = d.self.Set(d.changes) // we control self, Set will not error
+	}
+}
+
+func (d *derivedString) AddListener(l binding.DataListener) {
+	d.self.AddListener(l)
+}
+
+func (d *derivedString) Get() (string, error) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	if d.prev != nil {
+		return "", d.prev
+	}
+	return d.val, nil
+}
+
+func (d *derivedString) RemoveListener(l binding.DataListener) {
+	d.self.RemoveListener(l)
+}
+
+func (d *derivedString) Set(val string) error {
+	if d.write == nil {
+		return errReadOnly
+	}
+
+	return d.write(val)
+}
+
+func (d *derivedString) Close() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.closed {
+		return nil
+	}
+
+	d.closed = true
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	for _, src := range d.sources {
+		src.RemoveListener(d.listener)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package binding
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	src := binding.NewString()
+	src.Set("hello")
+
+	upper := Map(src, func(s string) (string, error) {
+		if s == "" {
+			return "", errors.New("empty")
+		}
+		return strings.ToUpper(s), nil
+	})
+	defer upper.Close()
+
+	waitFor(t, stringEquals(upper, "HELLO"))
+	src.Set("")
+	waitFor(t, func() bool {
+		_, err := upper.Get()
+		return err != nil
+	})
+	src.Set("world")
+	waitFor(t, stringEquals(upper, "WORLD"))
+
+	assert.Error(t, upper.Set("read only"))
+
+	assert.NoError(t, upper.Close())
+	src.Set("closed")
+	time.Sleep(50 * time.Millisecond)
+	v, err := upper.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "WORLD", v)
+}
+
+func TestMap_ErrorChanges(t *testing.T) {
+	src := binding.NewString()
+	src.Set("hello")
+	checked := Map(src, func(s string) (string, error) {
+		if s == "" {
+			return "", errors.New("empty")
+		}
+		return "hello", nil
+	})
+	defer checked.Close()
+
+	lock := sync.Mutex{}
+	updates := 0
+	checked.AddListener(binding.NewDataListener(func() {
+		lock.Lock()
+		updates++
+		lock.Unlock()
+	}))
+	count := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return updates
+	}
+	waitFor(t, stringEquals(checked, "hello"))
+	time.Sleep(50 * time.Millisecond) // the first notifications
+	initial := count()
+
+	src.Set("")
+	waitFor(t, func() bool { return count() == initial+1 }) // into the error
+	src.Set("world")
+	waitFor(t, func() bool { return count() == initial+2 }) // out of the error, with the same value
+	v, err := checked.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", v)
+}
+
+func TestCombine(t *testing.T) {
+	first, last := binding.NewString(), binding.NewString()
+	first.Set("Ada")
+	last.Set("Lovelace")
+
+	name := Combine(first, last, func(a, b string) (string, error) {
+		return a + " " + b, nil
+	})
+	defer name.Close()
+
+	waitFor(t, stringEquals(name, "Ada Lovelace"))
+	last.Set("Byron")
+	waitFor(t, stringEquals(name, "Ada Byron"))
+}
+
+func TestDebounce(t *testing.T) {
+	src := binding.NewString()
+	src.Set("initial")
+
+	s := Debounce(src, 50*time.Millisecond)
+	defer s.Close()
+
+	v, err := s.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "initial", v)
+
+	for _, val := range []string{"a", "ab", "abc"} {
+		src.Set(val)
+		time.Sleep(5 * time.Millisecond)
+	}
+	v, _ = s.Get()
+	assert.Equal(t, "initial", v)
+	waitFor(t, stringEquals(s, "abc"))
+
+	assert.NoError(t, s.Set("written"))
+	v, _ = src.Get()
+	assert.Equal(t, "written", v)
+}
+
+func TestThrottle(t *testing.T) {
+	src := binding.NewString()
+	s := Throttle(src, 100*time.Millisecond)
+	defer s.Close()
+
+	lock := sync.Mutex{}
+	updates := 0
+	s.AddListener(binding.NewDataListener(func() {
+		lock.Lock()
+		updates++
+		lock.Unlock()
+	}))
+
+	for i := 0; i < 20; i++ {
+		src.Set(strings.Repeat("x", i+1))
+		time.Sleep(2 * time.Millisecond)
+	}
+	waitFor(t, stringEquals(s, strings.Repeat("x", 20)))
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Less(t, updates, 10)
+}
+
+// chattyString notifies its listeners each time it is set, even if the value did not change.
+type chattyString struct {
+	binding.String
+	listeners []binding.DataListener
+}
+
+func (c *chattyString) AddListener(l binding.DataListener) {
+	c.listeners = append(c.listeners, l)
+	l.DataChanged()
+}
+
+func (c *chattyString) RemoveListener(l binding.DataListener) {
+	c.listeners = nil
+}
+
+func (c *chattyString) Set(val string) error {
+	c.String.Set(val)
+	for _, l := range c.listeners {
+		l.DataChanged()
+	}
+	return nil
+}
+
+func TestDistinct(t *testing.T) {
+	src := &chattyString{String: binding.NewString()}
+	s := Distinct(src)
+	defer s.Close()
+
+	lock := sync.Mutex{}
+	updates := 0
+	s.AddListener(binding.NewDataListener(func() {
+		lock.Lock()
+		updates++
+		lock.Unlock()
+	}))
+	count := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return updates
+	}
+	waitFor(t, func() bool { return count() == 1 })
+
+	src.Set("value")
+	waitFor(t, func() bool { return count() == 2 })
+	src.Set("value")
+	src.Set("value")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 2, count())
+}
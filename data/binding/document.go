@@ -0,0 +1,308 @@
+package binding
+
+import (
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// document is a parsed structured text, such as YAML or TOML, that can be bound with the
+// `JSONValue` accessors.
+type document interface {
+	// get returns the value at `path`, which is a string, an int64, a float64 or a bool.
+	get(path []interface{}) (interface{}, error)
+	// set changes the value at `path`, creating it if needed, and returns the new text of the document.
+	set(path []interface{}, val interface{}) (string, error)
+	isEmpty() bool
+}
+
+// databoundDocument implements `JSONValue` for the formats other than JSON.
+type databoundDocument struct {
+	self   binding.Untyped
+	rwlock sync.RWMutex
+	source binding.String
+	parse  func(string) (document, error)
+	err    error
+}
+
+type childDocument struct {
+	source *databoundDocument
+	path   []interface{}
+
+	lock sync.RWMutex
+	err  error
+}
+
+type childDocumentString struct {
+	binding.String
+	*childDocument
+}
+
+type childDocumentFloat struct {
+	binding.Float
+	*childDocument
+}
+
+type childDocumentInt struct {
+	binding.Int
+	*childDocument
+}
+
+type childDocumentBool struct {
+	binding.Bool
+	*childDocument
+}
+
+var (
+	errPathNotFound = errors.New("path not found in document")
+)
+
+func newDocument(data binding.String, parse func(string) (document, error)) *databoundDocument {
+	ret := &databoundDocument{self: binding.NewUntyped(), source: data, parse: parse}
+	data.AddListener(binding.NewDataListener(ret.changed))
+
+	return ret
+}
+
+// IsEmpty report true only if the data binding has already received a valid document with some content
+func (d *databoundDocument) IsEmpty() bool {
+	d.rwlock.RLock()
+	defer d.rwlock.RUnlock()
+
+	doc, err := d.get()
+	if err != nil {
+		return true
+	}
+	return doc.isEmpty()
+}
+
+func (d *databoundDocument) AddListener(listener binding.DataListener) {
+	d.self.AddListener(listener)
+}
+
+func (d *databoundDocument) RemoveListener(listener binding.DataListener) {
+	d.self.RemoveListener(listener)
+}
+
+func (d *databoundDocument) GetItemString(firstParam interface{}, params ...interface{}) (binding.String, error) {
+	ret := &childDocumentString{String: binding.NewString(), childDocument: d.child(firstParam, params)}
+	d.AddListener(binding.NewDataListener(ret.changed))
+
+	return ret, nil
+}
+
+func (d *databoundDocument) GetItemFloat(firstParam interface{}, params ...interface{}) (binding.Float, error) {
+	ret := &childDocumentFloat{Float: binding.NewFloat(), childDocument: d.child(firstParam, params)}
+	d.AddListener(binding.NewDataListener(ret.changed))
+
+	return ret, nil
+}
+
+func (d *databoundDocument) GetItemInt(firstParam interface{}, params ...interface{}) (binding.Int, error) {
+	ret := &childDocumentInt{Int: binding.NewInt(), childDocument: d.child(firstParam, params)}
+	d.AddListener(binding.NewDataListener(ret.changed))
+
+	return ret, nil
+}
+
+func (d *databoundDocument) GetItemBool(firstParam interface{}, params ...interface{}) (binding.Bool, error) {
+	ret := &childDocumentBool{Bool: binding.NewBool(), childDocument: d.child(firstParam, params)}
+	d.AddListener(binding.NewDataListener(ret.changed))
+
+	return ret, nil
+}
+
+func (d *databoundDocument) child(firstParam interface{}, params []interface{}) *childDocument {
+	return &childDocument{source: d, path: append([]interface{}{firstParam}, params...)}
+}
+
+func (d *databoundDocument) get() (document, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	i, err := d.self.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	doc, ok := i.(document)
+	if !ok {
+		return nil, errWrongType
+	}
+	return doc, nil
+}
+
+func (d *databoundDocument) changed() {
+	s, err := d.source.Get()
+	if err == nil {
+		var doc document
+		doc, err = d.parse(s)
+		if err == nil {
+			d.rwlock.Lock()
+			d.err = nil
+			d.rwlock.Unlock()
+			_ = d.self.Set(doc) // we control self, Set will not error
+			return
+		}
+	}
+
+	d.rwlock.Lock()
+	d.err = err
+	d.rwlock.Unlock()
+	empty, _ := d.parse("") // an empty text is always valid
+	_ = d.self.Set(empty)   // we control self, Set will not error
+}
+
+// value returns the value of the child in the current document, nil if the document is empty.
+func (c *childDocument) value() (interface{}, error) {
+	c.source.rwlock.RLock()
+	defer c.source.rwlock.RUnlock()
+
+	doc, err := c.source.get()
+	if err != nil {
+		return nil, err
+	}
+	if doc.isEmpty() {
+		return nil, nil
+	}
+	return doc.get(c.path)
+}
+
+func (c *childDocument) setValue(val interface{}) error {
+	c.source.rwlock.Lock()
+	defer c.source.rwlock.Unlock()
+
+	doc, err := c.source.get()
+	if err != nil {
+		return err
+	}
+
+	s, err := doc.set(c.path, val)
+	if err != nil {
+		return err
+	}
+	return c.source.source.Set(s)
+}
+
+func (c *childDocument) setError(err error) {
+	c.lock.Lock()
+	c.err = err
+	c.lock.Unlock()
+}
+
+func (c *childDocument) getError() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.err
+}
+
+func (child *childDocumentString) changed() {
+	v, err := child.value()
+	s, ok := v.(string)
+	if err == nil && v != nil && !ok {
+		err = errWrongType
+	}
+	child.setError(err)
+	if err == nil {
+		_ = child.String.Set(s) // we control child, Set will not error
+	}
+}
+
+func (child *childDocumentString) Get() (string, error) {
+	if err := child.getError(); err != nil {
+		return "", err
+	}
+	return child.String.Get()
+}
+
+func (child *childDocumentString) Set(val string) error {
+	return child.setValue(val)
+}
+
+func (child *childDocumentFloat) changed() {
+	v, err := child.value()
+	var f float64
+	switch n := v.(type) {
+	case nil:
+	case float64:
+		f = n
+	case int64:
+		f = float64(n)
+	default:
+		if err == nil {
+			err = errWrongType
+		}
+	}
+	child.setError(err)
+	if err == nil {
+		_ = child.Float.Set(f) // we control child, Set will not error
+	}
+}
+
+func (child *childDocumentFloat) Get() (float64, error) {
+	if err := child.getError(); err != nil {
+		return 0, err
+	}
+	return child.Float.Get()
+}
+
+func (child *childDocumentFloat) Set(val float64) error {
+	return child.setValue(val)
+}
+
+func (child *childDocumentInt) changed() {
+	v, err := child.value()
+	var i int
+	switch n := v.(type) {
+	case nil:
+	case int64:
+		i = int(n)
+	case float64:
+		i = int(n)
+	default:
+		if err == nil {
+			err = errWrongType
+		}
+	}
+	child.setError(err)
+	if err == nil {
+		_ = child.Int.Set(i) // we control child, Set will not error
+	}
+}
+
+func (child *childDocumentInt) Get() (int, error) {
+	if err := child.getError(); err != nil {
+		return 0, err
+	}
+	return child.Int.Get()
+}
+
+func (child *childDocumentInt) Set(val int) error {
+	return child.setValue(int64(val))
+}
+
+func (child *childDocumentBool) changed() {
+	v, err := child.value()
+	b, ok := v.(bool)
+	if err == nil && v != nil && !ok {
+		err = errWrongType
+	}
+	child.setError(err)
+	if err == nil {
+		_ = child.Bool.Set(b) // we control child, Set will not error
+	}
+}
+
+func (child *childDocumentBool) Get() (bool, error) {
+	if err := child.getError(); err != nil {
+		return false, err
+	}
+	return child.Bool.Get()
+}
+
+func (child *childDocumentBool) Set(val bool) error {
+	return child.setValue(val)
+}
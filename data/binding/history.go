@@ -0,0 +1,248 @@
+package binding
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// History records the successive values of a data binding so that changes can be undone and redone.
+type History interface {
+	io.Closer
+
+	// Undo restores the value the data binding had before the latest change.
+	// It fails while a transaction is in progress.
+	Undo() error
+	// Redo applies again the latest change that has been undone.
+	// It fails while a transaction is in progress.
+	Redo() error
+	// CanUndo returns a `Bool` binding reporting if there is a change to undo.
+	CanUndo() binding.Bool
+	// CanRedo returns a `Bool` binding reporting if there is a change to redo.
+	CanRedo() binding.Bool
+
+	// Begin starts a transaction, all the changes made until the matching `Commit` are grouped
+	// so that they are undone at once. Transactions can be nested.
+	Begin()
+	// Commit ends a transaction started with `Begin`.
+	Commit()
+}
+
+type history struct {
+	item     binding.DataItem
+	get, set reflect.Value
+	depth    int
+	listener binding.DataListener
+
+	lock        sync.Mutex
+	current     interface{}
+	undo, redo  []interface{}
+	transaction int
+	start       interface{}
+
+	canUndo, canRedo binding.Bool
+}
+
+var (
+	errNotRecordable = errors.New("data binding does not support Get and Set")
+	errNothingToUndo = errors.New("nothing to undo")
+	errNothingToRedo = errors.New("nothing to redo")
+	errHistoryDepth  = errors.New("history depth can not be negative")
+	errTransaction   = errors.New("transaction in progress")
+)
+
+// NewHistory returns a `History` recording the value changes of `item`, keeping at most `depth` changes,
+// or all of them if `depth` is 0. An error is returned if `depth` is negative.
+// Any data binding with `Get` and `Set` methods is supported, such as `String`, `Float` or `Untyped`.
+// For a `JSONValue`, including YAML and TOML documents, the changes of the whole document are recorded.
+// You should call `Close()` on the history once you are done to stop recording.
+func NewHistory(item binding.DataItem, depth int) (History, error) {
+	if depth < 0 {
+		return nil, errHistoryDepth
+	}
+
+	switch doc := item.(type) {
+	case *databoundJSON:
+		item = doc.source
+	case *databoundDocument:
+		item = doc.source
+	}
+
+	h := &history{item: item, depth: depth, canUndo: binding.NewBool(), canRedo: binding.NewBool()}
+	h.get = reflect.ValueOf(item).MethodByName("Get")
+	h.set = reflect.ValueOf(item).MethodByName("Set")
+	if !isGetter(h.get) || !isSetter(h.set, h.get) {
+		return nil, errNotRecordable
+	}
+
+	val, err := h.value()
+	if err != nil {
+		return nil, err
+	}
+	h.current = val
+
+	h.listener = binding.NewDataListener(h.changed)
+	item.AddListener(h.listener)
+	return h, nil
+}
+
+func (h *history) CanUndo() binding.Bool {
+	return h.canUndo
+}
+
+func (h *history) CanRedo() binding.Bool {
+	return h.canRedo
+}
+
+func (h *history) Undo() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.record()
+	if h.transaction > 0 {
+		return errTransaction
+	}
+	if len(h.undo) == 0 {
+		return errNothingToUndo
+	}
+
+	prev := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, h.current)
+	return h.restore(prev)
+}
+
+func (h *history) Redo() error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.record()
+	if h.transaction > 0 {
+		return errTransaction
+	}
+	if len(h.redo) == 0 {
+		return errNothingToRedo
+	}
+
+	next := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, h.current)
+	return h.restore(next)
+}
+
+func (h *history) Begin() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.record()
+	if h.transaction == 0 {
+		h.start = h.current
+	}
+	h.transaction++
+}
+
+func (h *history) Commit() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.transaction == 0 {
+		return
+	}
+
+	h.record()
+	h.transaction--
+	if h.transaction == 0 && !reflect.DeepEqual(h.start, h.current) {
+		h.push(h.start)
+	}
+}
+
+func (h *history) Close() error {
+	h.item.RemoveListener(h.listener)
+	return nil
+}
+
+func (h *history) changed() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.record()
+}
+
+// record checks the value of the data binding and records it if it changed.
+// Listeners are called asynchronously, so this is also done before any operation on the history.
+func (h *history) record() {
+	val, err := h.value()
+	if err != nil || reflect.DeepEqual(val, h.current) {
+		return
+	}
+
+	prev := h.current
+	h.current = val
+	if h.transaction == 0 {
+		h.push(prev)
+	}
+}
+
+func (h *history) push(val interface{}) {
+	h.undo = append(h.undo, val)
+	if h.depth > 0 && len(h.undo) > h.depth {
+		h.undo = h.undo[len(h.undo)-h.depth:]
+	}
+	h.redo = nil
+	h.updateState()
+}
+
+func (h *history) restore(val interface{}) error {
+	h.current = val
+	h.updateState()
+
+	arg := reflect.Zero(h.set.Type().In(0))
+	if val != nil {
+		arg = reflect.ValueOf(val)
+	}
+
+	ret := h.set.Call([]reflect.Value{arg})
+	if err, ok := ret[0].Interface().(error); ok && err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *history) updateState() {
+	h.canUndo.Set(len(h.undo) > 0)
+	h.canRedo.Set(len(h.redo) > 0)
+}
+
+func (h *history) value() (interface{}, error) {
+	ret := h.get.Call(nil)
+	if err, ok := ret[1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+	val := ret[0]
+	if val.Kind() == reflect.Slice && !val.IsNil() { // lists can be modified in place
+		val = reflect.AppendSlice(reflect.MakeSlice(val.Type(), 0, val.Len()), val)
+	}
+	return val.Interface(), nil
+}
+
+func isGetter(get reflect.Value) bool {
+	if !get.IsValid() {
+		return false
+	}
+
+	t := get.Type()
+	return t.NumIn() == 0 && t.NumOut() == 2 && t.Out(1) == reflect.TypeOf((*error)(nil)).Elem()
+}
+
+func isSetter(set, get reflect.Value) bool {
+	if !set.IsValid() {
+		return false
+	}
+
+	t := set.Type()
+	return t.NumIn() == 1 && t.In(0) == get.Type().Out(0) &&
+		t.NumOut() == 1 && t.Out(0) == reflect.TypeOf((*error)(nil)).Elem()
+}
@@ -0,0 +1,175 @@
+package binding
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func boolValue(b binding.Bool) bool {
+	v, _ := b.Get()
+	return v
+}
+
+func TestHistory(t *testing.T) {
+	s := binding.NewString()
+	h, err := NewHistory(s, 10)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	assert.False(t, boolValue(h.CanUndo()))
+	assert.Error(t, h.Undo())
+
+	s.Set("a")
+	waitFor(t, func() bool { return boolValue(h.CanUndo()) })
+	s.Set("ab")
+	h.Begin() // records the pending change
+	h.Commit()
+
+	assert.NoError(t, h.Undo())
+	v, _ := s.Get()
+	assert.Equal(t, "a", v)
+	assert.True(t, boolValue(h.CanRedo()))
+
+	assert.NoError(t, h.Undo())
+	v, _ = s.Get()
+	assert.Equal(t, "", v)
+	assert.False(t, boolValue(h.CanUndo()))
+
+	assert.NoError(t, h.Redo())
+	v, _ = s.Get()
+	assert.Equal(t, "a", v)
+
+	// a new change drops the changes to redo
+	s.Set("new")
+	assert.NoError(t, h.Undo())
+	assert.NoError(t, h.Redo())
+	assert.Error(t, h.Redo())
+	v, _ = s.Get()
+	assert.Equal(t, "new", v)
+}
+
+func TestHistory_Transaction(t *testing.T) {
+	f := binding.NewFloat()
+	h, err := NewHistory(f, 10)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	f.Set(1)
+	h.Begin()
+	f.Set(2)
+	h.Begin()
+	f.Set(3)
+	h.Commit()
+	f.Set(4)
+	h.Commit()
+
+	assert.NoError(t, h.Undo())
+	v, _ := f.Get()
+	assert.Equal(t, 1.0, v)
+	assert.NoError(t, h.Undo())
+	v, _ = f.Get()
+	assert.Equal(t, 0.0, v)
+	assert.Error(t, h.Undo())
+
+	h.Begin()
+	f.Set(5)
+	assert.Equal(t, errTransaction, h.Undo())
+	assert.Equal(t, errTransaction, h.Redo())
+	h.Commit()
+	assert.NoError(t, h.Undo())
+	v, _ = f.Get()
+	assert.Equal(t, 0.0, v)
+}
+
+func TestHistory_Depth(t *testing.T) {
+	i := binding.NewInt()
+	h, err := NewHistory(i, 2)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	for n := 1; n <= 5; n++ {
+		i.Set(n)
+		h.Begin() // records the pending change
+		h.Commit()
+	}
+
+	assert.NoError(t, h.Undo())
+	assert.NoError(t, h.Undo())
+	assert.Error(t, h.Undo())
+	v, _ := i.Get()
+	assert.Equal(t, 3, v)
+}
+
+func TestHistory_Unlimited(t *testing.T) {
+	_, err := NewHistory(binding.NewInt(), -1)
+	assert.Equal(t, errHistoryDepth, err)
+
+	i := binding.NewInt()
+	h, err := NewHistory(i, 0)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	for n := 1; n <= 5; n++ {
+		i.Set(n)
+		h.Begin() // records the pending change
+		h.Commit()
+	}
+	for n := 4; n >= 0; n-- {
+		assert.NoError(t, h.Undo())
+		v, _ := i.Get()
+		assert.Equal(t, n, v)
+	}
+	assert.Error(t, h.Undo())
+}
+
+func TestHistory_JSON(t *testing.T) {
+	s := binding.NewString()
+	s.Set(`{"name": "first"}`)
+	json, err := NewJSONFromString(s)
+	assert.NoError(t, err)
+
+	h, err := NewHistory(json, 10)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	s.Set(`{"name": "second"}`)
+	assert.NoError(t, h.Undo())
+	v, _ := s.Get()
+	assert.Equal(t, `{"name": "first"}`, v)
+}
+
+func TestHistory_Untyped(t *testing.T) {
+	u := binding.NewUntyped()
+	u.Set("first")
+	h, err := NewHistory(u, 10)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	u.Set(42)
+	assert.NoError(t, h.Undo())
+	v, _ := u.Get()
+	assert.Equal(t, "first", v)
+}
+
+func TestHistory_List(t *testing.T) {
+	l := binding.NewStringList()
+	h, err := NewHistory(l, 10)
+	assert.NoError(t, err)
+	defer h.Close()
+
+	l.Append("one")
+	h.Begin() // records the pending change
+	h.Commit()
+	l.SetValue(0, "two")
+	assert.NoError(t, h.Undo())
+	v, _ := l.Get()
+	assert.Equal(t, []string{"one"}, v)
+}
+
+func TestHistory_Unsupported(t *testing.T) {
+	_, err := NewHistory(binding.NewStringTree(), 10)
+	assert.Error(t, err)
+}
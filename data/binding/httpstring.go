@@ -0,0 +1,174 @@
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// HTTPPollingOptions configures how a binding created by `NewHTTPPollingString` polls the server.
+type HTTPPollingOptions struct {
+	// Client is used to send the requests, http.DefaultClient is used if nil.
+	Client *http.Client
+	// Header contains additional headers sent with every request.
+	Header http.Header
+	// MaxBackoff is the longest delay between two requests when the server keeps failing.
+	// The delay doubles after each error, starting from the polling interval. It defaults to a minute,
+	// and is raised to the polling interval if shorter, so that a failing server is not polled more often.
+	MaxBackoff time.Duration
+}
+
+var errHTTPInterval = errors.New("polling interval must be positive")
+
+type httpPollingString struct {
+	binding.String
+	url      string
+	interval time.Duration
+	opts     HTTPPollingOptions
+
+	lock         sync.RWMutex
+	prev         error
+	etag         string
+	lastModified string
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewHTTPPollingString returns a `String` binding to the content of a resource at `url`, which is
+// fetched again at every `interval`. The `ETag` and `Last-Modified` headers sent by the server are
+// used to make conditional requests, so that unchanged content is not downloaded again.
+// When a request fails, the delay before the next one grows up to `opts.MaxBackoff`, and `Get` returns
+// the error until the server answers again. `opts` can be nil to use the default options.
+// An error is returned if `interval` is not positive.
+// You should also call `Close()` on the binding once you are done to stop polling.
+func NewHTTPPollingString(url string, interval time.Duration, opts *HTTPPollingOptions) (StringCloser, error) {
+	if interval <= 0 {
+		return nil, errHTTPInterval
+	}
+	if _, err := http.NewRequest(http.MethodGet, url, nil); err != nil {
+		return nil, err
+	}
+
+	ret := &httpPollingString{String: binding.NewString(), url: url, interval: interval, done: make(chan struct{})}
+	if opts != nil {
+		ret.opts = *opts
+	}
+	if ret.opts.Client == nil {
+		ret.opts.Client = http.DefaultClient
+	}
+	if ret.opts.MaxBackoff <= 0 {
+		ret.opts.MaxBackoff = time.Minute
+	}
+	if ret.opts.MaxBackoff < interval {
+		ret.opts.MaxBackoff = interval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ret.cancel = cancel
+	go ret.poll(ctx)
+	return ret, nil
+}
+
+func (s *httpPollingString) Close() error {
+	s.lock.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.lock.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	<-s.done
+	return nil
+}
+
+func (s *httpPollingString) Get() (string, error) {
+	s.lock.RLock()
+	err := s.prev
+	s.lock.RUnlock()
+	if err != nil {
+		return "", err
+	}
+
+	return s.String.Get()
+}
+
+func (s *httpPollingString) poll(ctx context.Context) {
+	defer close(s.done)
+
+	delay := s.interval
+	for {
+		err := s.fetch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.lock.Lock()
+		s.prev = err // if no error we clear the state
+		s.lock.Unlock()
+
+		if err == nil {
+			delay = s.interval
+		} else {
+			delay *= 2
+			if delay > s.opts.MaxBackoff {
+				delay = s.opts.MaxBackoff
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (s *httpPollingString) fetch(ctx context.Context) error {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	for key, values := range s.opts.Header {
+		req.Header[key] = values
+	}
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	if s.lastModified != "" {
+		req.Header.Set("If-Modified-Since", s.lastModified)
+	}
+
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	s.etag = resp.Header.Get("ETag")
+	s.lastModified = resp.Header.Get("Last-Modified")
+	return s.String.Set(string(body))
+}
@@ -0,0 +1,114 @@
+package binding
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPPollingString(t *testing.T) {
+	lock := sync.Mutex{}
+	content, etag := "first", `"1"`
+	notModified := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	s, err := NewHTTPPollingString(server.URL, 10*time.Millisecond, nil)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, stringEquals(s, "first"))
+	waitFor(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return notModified > 0
+	})
+
+	lock.Lock()
+	content, etag = "second", `"2"`
+	lock.Unlock()
+	waitFor(t, stringEquals(s, "second"))
+
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+}
+
+func TestHTTPPollingString_Error(t *testing.T) {
+	lock := sync.Mutex{}
+	failing := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("up"))
+	}))
+	defer server.Close()
+
+	s, err := NewHTTPPollingString(server.URL, 10*time.Millisecond,
+		&HTTPPollingOptions{MaxBackoff: 20 * time.Millisecond})
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, func() bool {
+		_, err := s.Get()
+		return err != nil
+	})
+
+	lock.Lock()
+	failing = false
+	lock.Unlock()
+	waitFor(t, stringEquals(s, "up"))
+}
+
+func TestHTTPPollingString_BackoffBelowInterval(t *testing.T) {
+	lock := sync.Mutex{}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests++
+		lock.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s, err := NewHTTPPollingString(server.URL, 100*time.Millisecond,
+		&HTTPPollingOptions{MaxBackoff: 10 * time.Millisecond})
+	assert.NoError(t, err)
+	defer s.Close()
+
+	time.Sleep(250 * time.Millisecond)
+	lock.Lock()
+	defer lock.Unlock()
+	assert.LessOrEqual(t, requests, 3) // at most one request per interval
+}
+
+func TestHTTPPollingString_InvalidURL(t *testing.T) {
+	_, err := NewHTTPPollingString("://invalid", time.Second, nil)
+	assert.Error(t, err)
+}
+
+func TestHTTPPollingString_InvalidInterval(t *testing.T) {
+	_, err := NewHTTPPollingString("http://localhost", 0, nil)
+	assert.Equal(t, errHTTPInterval, err)
+	_, err = NewHTTPPollingString("http://localhost", -time.Second, nil)
+	assert.Equal(t, errHTTPInterval, err)
+}
@@ -0,0 +1,45 @@
+package binding
+
+import (
+	"errors"
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+var errNegativeLines = errors.New("the number of lines can not be negative")
+
+// lineList is a `StringList` keeping only the latest lines received from a source, or all of them
+// if max is 0.
+type lineList struct {
+	binding.StringList
+	max int
+
+	lock  sync.Mutex
+	lines []string
+}
+
+func newLineList(max int) *lineList {
+	return &lineList{StringList: binding.NewStringList(), max: max}
+}
+
+func (l *lineList) add(lines []string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.lines = append(l.lines, lines...)
+	if l.max > 0 && len(l.lines) > l.max {
+		l.lines = append([]string{}, l.lines[len(l.lines)-l.max:]...)
+	}
+
+	_ = l.StringList.Set(append([]string{}, l.lines...)) // we control l, Set will not error
+}
+
+// lastLine returns a function setting a `String` to the last of the lines received.
+func lastLine(s binding.String) func([]string) {
+	return func(lines []string) {
+		if len(lines) > 0 {
+			_ = s.Set(lines[len(lines)-1]) // we control s, Set will not error
+		}
+	}
+}
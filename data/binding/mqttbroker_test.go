@@ -0,0 +1,296 @@
+package binding
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBroker is a minimal in-process MQTT 3.1.1 broker, only supporting what the bindings need:
+// QoS 0 delivery, wildcard subscriptions and retained messages.
+type testBroker struct {
+	t    *testing.T
+	addr string
+
+	lock     sync.Mutex
+	listener net.Listener
+	clients  map[net.Conn]*testBrokerClient
+	retained map[string][]byte
+
+	conns    map[string]mqtt.Client
+	connects map[string]int // connections accepted by client ID
+	lost     map[string]int // connections reported lost by client ID
+}
+
+type testBrokerClient struct {
+	lock sync.Mutex
+	conn net.Conn
+	subs map[string]bool
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	b := &testBroker{t: t, clients: make(map[net.Conn]*testBrokerClient), retained: make(map[string][]byte),
+		conns: make(map[string]mqtt.Client), connects: make(map[string]int), lost: make(map[string]int)}
+	b.listen("127.0.0.1:0")
+	t.Cleanup(b.close)
+
+	return b
+}
+
+// testPublisher publishes messages with its own client of the test broker.
+type testPublisher struct {
+	conn mqtt.Client
+}
+
+// newPublisher returns a publisher with a client connected to the broker.
+func (b *testBroker) newPublisher() *testPublisher {
+	return &testPublisher{conn: b.newClient(false)}
+}
+
+func (p *testPublisher) publish(topic, payload string, retain bool) error {
+	token := p.conn.Publish(topic, 0, retain, payload)
+	if !token.WaitTimeout(time.Second) {
+		return errors.New("publish timed out")
+	}
+	return token.Error()
+}
+
+// newClient returns a client connected to the broker, that reconnects automatically until the
+// broker is closed.
+func (b *testBroker) newClient(watch bool) mqtt.Client {
+	b.lock.Lock()
+	id := "test-" + strconv.Itoa(len(b.conns))
+	b.lock.Unlock()
+
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker("tcp://" + b.addr)
+	opts.SetClientID(id)
+	opts.SetConnectionLostHandler(func(mqtt.Client, error) {
+		b.lock.Lock()
+		b.lost[id]++
+		b.lock.Unlock()
+	})
+	opts.SetAutoReconnect(true)
+	opts.SetMaxReconnectInterval(100 * time.Millisecond)
+	if watch {
+		WatchMqttConnection(opts)
+	}
+
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	assert.True(b.t, token.WaitTimeout(time.Second))
+	assert.NoError(b.t, token.Error())
+
+	b.lock.Lock()
+	b.conns[id] = client
+	b.lock.Unlock()
+	return client
+}
+
+func (b *testBroker) listen(addr string) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		b.t.Fatal(err)
+	}
+
+	b.lock.Lock()
+	b.listener = l
+	b.addr = l.Addr().String()
+	b.lock.Unlock()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+}
+
+// drop closes the connection of every client, they will be able to reconnect immediately.
+func (b *testBroker) drop() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for conn := range b.clients {
+		conn.Close()
+	}
+}
+
+// stop closes the listener and the connection of every client.
+func (b *testBroker) stop() {
+	b.lock.Lock()
+	b.listener.Close()
+	b.lock.Unlock()
+
+	b.drop()
+}
+
+// close stops the broker, then disconnects each client once it has handled the loss of every
+// connection, as the paho client is not safe to disconnect while it is connecting again.
+func (b *testBroker) close() {
+	b.stop()
+
+	b.lock.Lock()
+	conns := make(map[string]mqtt.Client, len(b.conns))
+	for id, c := range b.conns {
+		conns[id] = c
+	}
+	b.lock.Unlock()
+
+	for id, c := range conns {
+		waitFor(b.t, func() bool {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			return b.lost[id] >= b.connects[id]
+		})
+		c.Disconnect(0)
+	}
+}
+
+// restart listens again on the same address after a stop.
+func (b *testBroker) restart() {
+	b.listen(b.addr)
+}
+
+func (b *testBroker) serve(conn net.Conn) {
+	client := &testBrokerClient{conn: conn, subs: make(map[string]bool)}
+	defer func() {
+		b.lock.Lock()
+		delete(b.clients, conn)
+		b.lock.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		p, err := packets.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+
+		switch p := p.(type) {
+		case *packets.ConnectPacket:
+			b.lock.Lock()
+			b.clients[conn] = client
+			b.connects[p.ClientIdentifier]++
+			b.lock.Unlock()
+			client.send(packets.NewControlPacket(packets.Connack))
+		case *packets.PingreqPacket:
+			client.send(packets.NewControlPacket(packets.Pingresp))
+		case *packets.SubscribePacket:
+			ack := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			ack.MessageID = p.MessageID
+			client.lock.Lock()
+			for _, topic := range p.Topics {
+				client.subs[topic] = true
+				ack.ReturnCodes = append(ack.ReturnCodes, 0)
+			}
+			client.lock.Unlock()
+			client.send(ack)
+			b.sendRetained(client, p.Topics)
+		case *packets.UnsubscribePacket:
+			client.lock.Lock()
+			for _, topic := range p.Topics {
+				delete(client.subs, topic)
+			}
+			client.lock.Unlock()
+			ack := packets.NewControlPacket(packets.Unsuback).(*packets.UnsubackPacket)
+			ack.MessageID = p.MessageID
+			client.send(ack)
+		case *packets.PublishPacket:
+			if p.Qos > 0 {
+				ack := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+				ack.MessageID = p.MessageID
+				client.send(ack)
+			}
+			b.publish(p.TopicName, p.Payload, p.Retain)
+		case *packets.DisconnectPacket:
+			return
+		}
+	}
+}
+
+func (b *testBroker) publish(topic string, payload []byte, retain bool) {
+	b.lock.Lock()
+	if retain {
+		if len(payload) == 0 {
+			delete(b.retained, topic)
+		} else {
+			b.retained[topic] = payload
+		}
+	}
+	clients := make([]*testBrokerClient, 0, len(b.clients))
+	for _, c := range b.clients {
+		clients = append(clients, c)
+	}
+	b.lock.Unlock()
+
+	for _, c := range clients {
+		if c.subscribed(topic) {
+			c.send(newTestPublish(topic, payload, false))
+		}
+	}
+}
+
+func (b *testBroker) sendRetained(client *testBrokerClient, filters []string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for topic, payload := range b.retained {
+		for _, filter := range filters {
+			if mqttTopicMatches(filter, topic) {
+				client.send(newTestPublish(topic, payload, true))
+				break
+			}
+		}
+	}
+}
+
+func (c *testBrokerClient) subscribed(topic string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for filter := range c.subs {
+		if mqttTopicMatches(filter, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *testBrokerClient) send(p packets.ControlPacket) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_ = p.Write(c.conn)
+}
+
+func newTestPublish(topic string, payload []byte, retain bool) *packets.PublishPacket {
+	p := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	p.TopicName = topic
+	p.Payload = payload
+	p.Retain = retain
+
+	return p
+}
+
+// waitFor polls a condition until it is true or the test times out.
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(3 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			assert.Fail(t, "The condition was not met before timeout")
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -1,48 +1,69 @@
 package binding
 
 import (
+	"sync"
+
 	"fyne.io/fyne/v2/data/binding"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 )
 
+// MqttString is a `StringCloser` bound to an MQTT topic that also reports the state of the
+// connection to the broker.
+type MqttString interface {
+	StringCloser
+
+	// Connected returns a `Bool` binding that turns false when the connection to the broker is lost
+	// and back to true once it has been restored and the topic subscribed again.
+	Connected() binding.Bool
+}
+
 type mqttString struct {
 	binding.String
-	conn  mqtt.Client
+	sub   *mqttSubscription
 	topic string
-	err   error
+
+	lock sync.RWMutex
+	err  error
 }
 
 // NewMqttString returns a `String` binding to a MQTT topic specified by combining a connected
 // mqtt.Client and a `topic`.
 // The resulting string will be set to the content of the latest message sent through the socket.
+// The subscription is restored automatically when the client reconnects to the broker.
 // You should also call `Close()` on the binding once you are done to free the connection.
-func NewMqttString(conn mqtt.Client, topic string) (StringCloser, error) {
-	ret := &mqttString{String: binding.NewString(), conn: conn, topic: topic}
+func NewMqttString(conn mqtt.Client, topic string) (MqttString, error) {
+	ret := &mqttString{String: binding.NewString(), topic: topic}
 
-	token := conn.Subscribe(topic, 1, func(c mqtt.Client, m mqtt.Message) {
+	sub, err := newMqttSubscription(conn, topic, func(c mqtt.Client, m mqtt.Message) {
 		ret.String.Set(string(m.Payload()))
 	})
-
-	token.Wait()
-
-	if err := token.Error(); err != nil {
+	if err != nil {
 		return nil, err
 	}
 
+	ret.sub = sub
 	return ret, nil
 }
 
+func (s *mqttString) Connected() binding.Bool {
+	return s.sub.connected
+}
+
 func (s *mqttString) Set(val string) error {
-	token := s.conn.Publish(s.topic, 0, false, val)
+	err := s.sub.publish(s.topic, val)
 
-	token.Wait()
-	s.err = token.Error()
-	return s.err
+	s.lock.Lock()
+	s.err = err
+	s.lock.Unlock()
+	return err
 }
 
 func (s *mqttString) Get() (string, error) {
-	if err := s.err; err != nil {
+	s.lock.RLock()
+	err := s.err
+	s.lock.RUnlock()
+	if err != nil {
 		return "", err
 	}
 
@@ -50,12 +71,5 @@ func (s *mqttString) Get() (string, error) {
 }
 
 func (s *mqttString) Close() error {
-	if s.conn == nil {
-		return nil
-	}
-
-	s.conn.Unsubscribe(s.topic)
-	s.conn = nil
-
-	return nil
+	return s.sub.close()
 }
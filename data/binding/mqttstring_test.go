@@ -0,0 +1,164 @@
+package binding
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stringEquals(s binding.String, expected string) func() bool {
+	return func() bool {
+		v, err := s.Get()
+		return err == nil && v == expected
+	}
+}
+
+func boolEquals(b binding.Bool, expected bool) func() bool {
+	return func() bool {
+		v, err := b.Get()
+		return err == nil && v == expected
+	}
+}
+
+// publishUntilReceived publishes numbered messages until one of them is received by the binding.
+func publishUntilReceived(t *testing.T, pub *testPublisher, topic string, s binding.String) {
+	prefix := strconv.FormatInt(time.Now().UnixNano(), 10) + "-"
+	i := 0
+	waitFor(t, func() bool {
+		i++
+		_ = pub.publish(topic, prefix+strconv.Itoa(i), false) // fails until reconnected
+		v, err := s.Get()
+		return err == nil && strings.HasPrefix(v, prefix)
+	})
+}
+
+func TestMqttString(t *testing.T) {
+	broker := newTestBroker(t)
+	client := broker.newClient(true)
+
+	s, err := NewMqttString(client, "fyne/test")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	other := broker.newClient(true)
+	echo, err := NewMqttString(other, "fyne/test")
+	assert.NoError(t, err)
+	defer echo.Close()
+
+	connected, err := s.Connected().Get()
+	assert.NoError(t, err)
+	assert.True(t, connected)
+
+	assert.NoError(t, echo.Set("hello"))
+	waitFor(t, stringEquals(s, "hello"))
+
+	assert.NoError(t, s.Set("world"))
+	waitFor(t, stringEquals(echo, "world"))
+}
+
+func TestMqttString_Close(t *testing.T) {
+	broker := newTestBroker(t)
+	client := broker.newClient(true)
+
+	s, err := NewMqttString(client, "fyne/test")
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+
+	assert.Equal(t, errBindingClosed, s.Set("value"))
+	_, err = s.Get()
+	assert.Error(t, err)
+}
+
+func TestMqttString_CloseDisconnected(t *testing.T) {
+	broker := newTestBroker(t)
+	client := broker.newClient(true)
+
+	s, err := NewMqttString(client, "fyne/test")
+	assert.NoError(t, err)
+
+	broker.stop()
+	waitFor(t, boolEquals(s.Connected(), false))
+
+	assert.Error(t, s.Close()) // the broker cannot acknowledge it, but the binding is closed
+	assert.Equal(t, errBindingClosed, s.Set("value"))
+	assert.NoError(t, s.Close())
+}
+
+func TestMqttString_Reconnect(t *testing.T) {
+	broker := newTestBroker(t)
+	client := broker.newClient(true)
+
+	s, err := NewMqttString(client, "fyne/test")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	pub := broker.newPublisher()
+	publishUntilReceived(t, pub, "fyne/test", s)
+
+	broker.drop()
+	publishUntilReceived(t, pub, "fyne/test", s)
+	waitFor(t, boolEquals(s.Connected(), true))
+}
+
+func TestMqttString_ReconnectWithoutWatch(t *testing.T) {
+	broker := newTestBroker(t)
+	client := broker.newClient(false)
+
+	s, err := NewMqttString(client, "fyne/test")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	broker.stop()
+	waitFor(t, boolEquals(s.Connected(), false))
+
+	broker.restart()
+	waitFor(t, boolEquals(s.Connected(), true))
+
+	pub := broker.newPublisher()
+	publishUntilReceived(t, pub, "fyne/test", s)
+}
+
+func TestMqttTopicTree(t *testing.T) {
+	broker := newTestBroker(t)
+	pub := broker.newPublisher()
+	assert.NoError(t, pub.publish("plant/a/temperature", "21", true))
+
+	tree, err := NewMqttTopicTree(broker.newClient(true), "plant/#")
+	assert.NoError(t, err)
+	defer tree.Close()
+
+	temperature, err := tree.GetTopic("plant/a/temperature")
+	assert.NoError(t, err)
+	waitFor(t, stringEquals(temperature, "21"))
+
+	assert.NoError(t, pub.publish("plant/b", "on", false))
+	waitFor(t, func() bool {
+		topics, _ := tree.Topics().Get()
+		return len(topics) == 2
+	})
+	assert.Equal(t, []string{"plant/a", "plant/b"}, tree.ChildIDs("plant"))
+
+	assert.NoError(t, pub.publish("plant/a/temperature", "", true))
+	waitFor(t, func() bool {
+		return len(tree.ChildIDs("plant")) == 1
+	})
+
+	broker.drop()
+	publishUntilReceived(t, pub, "plant/b", mustGetTopic(t, tree, "plant/b"))
+
+	assert.NoError(t, tree.Close())
+	assert.Equal(t, errBindingClosed, temperature.Set("22"))
+}
+
+func mustGetTopic(t *testing.T, tree MqttTopicTree, topic string) binding.String {
+	s, err := tree.GetTopic(topic)
+	assert.NoError(t, err)
+	return s
+}
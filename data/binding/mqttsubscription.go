@@ -0,0 +1,206 @@
+package binding
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// mqttWatchInterval is the delay between two checks of the MQTT connection state.
+var mqttWatchInterval = 250 * time.Millisecond
+
+var (
+	errBindingClosed      = errors.New("binding is closed")
+	errUnsubscribeTimeout = errors.New("timed out unsubscribing from the broker")
+)
+
+var (
+	mqttSubscriptionsLock sync.Mutex
+	mqttSubscriptions     = make(map[mqtt.Client][]*mqttSubscription)
+)
+
+// mqttSubscription holds a subscription of a binding to an MQTT client.
+// It follows the state of the client connection and restores the subscription once the client
+// has reconnected, as the paho client does not do it itself for clean sessions.
+type mqttSubscription struct {
+	lock    sync.RWMutex
+	conn    mqtt.Client
+	filter  string
+	handler mqtt.MessageHandler
+
+	connected binding.Bool
+	done      chan struct{}
+}
+
+// WatchMqttConnection sets up the connection handlers of MQTT client options so that the bindings
+// created with the resulting client are notified as soon as the connection is lost or restored.
+// Handlers already set on the options are still called.
+//
+// Bindings created on a client without these handlers check the connection periodically instead,
+// which can miss a connection that is restored very quickly.
+func WatchMqttConnection(opts *mqtt.ClientOptions) *mqtt.ClientOptions {
+	onConnect, onLost := opts.OnConnect, opts.OnConnectionLost
+
+	opts.SetOnConnectHandler(func(c mqtt.Client) {
+		for _, sub := range mqttSubscriptionsFor(c) {
+			sub.restore()
+		}
+		if onConnect != nil {
+			onConnect(c)
+		}
+	})
+	opts.SetConnectionLostHandler(func(c mqtt.Client, err error) {
+		for _, sub := range mqttSubscriptionsFor(c) {
+			sub.check(c.IsConnectionOpen()) // the connection might already be restored
+		}
+		if onLost != nil {
+			onLost(c, err)
+		}
+	})
+
+	return opts
+}
+
+func mqttSubscriptionsFor(conn mqtt.Client) []*mqttSubscription {
+	mqttSubscriptionsLock.Lock()
+	defer mqttSubscriptionsLock.Unlock()
+
+	return append([]*mqttSubscription{}, mqttSubscriptions[conn]...)
+}
+
+func newMqttSubscription(conn mqtt.Client, filter string, handler mqtt.MessageHandler) (*mqttSubscription, error) {
+	sub := &mqttSubscription{conn: conn, filter: filter, handler: handler, connected: binding.NewBool(),
+		done: make(chan struct{})}
+
+	token := conn.Subscribe(filter, 1, handler)
+
+	token.Wait()
+
+	if err := token.Error(); err != nil {
+		return nil, err
+	}
+	sub.connected.Set(conn.IsConnectionOpen())
+
+	mqttSubscriptionsLock.Lock()
+	mqttSubscriptions[conn] = append(mqttSubscriptions[conn], sub)
+	mqttSubscriptionsLock.Unlock()
+
+	go sub.watch()
+	return sub, nil
+}
+
+// client returns the MQTT client of this subscription, or an error if it has been closed.
+func (sub *mqttSubscription) client() (mqtt.Client, error) {
+	sub.lock.RLock()
+	defer sub.lock.RUnlock()
+
+	if sub.conn == nil {
+		return nil, errBindingClosed
+	}
+	return sub.conn, nil
+}
+
+func (sub *mqttSubscription) publish(topic, val string) error {
+	conn, err := sub.client()
+	if err != nil {
+		return err
+	}
+
+	token := conn.Publish(topic, 0, false, val)
+
+	token.Wait()
+	return token.Error()
+}
+
+func (sub *mqttSubscription) watch() {
+	ticker := time.NewTicker(mqttWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sub.done:
+			return
+		case <-ticker.C:
+			if conn, err := sub.client(); err == nil {
+				sub.check(conn.IsConnectionOpen())
+			}
+		}
+	}
+}
+
+// check updates the connection state, subscribing again when the connection has been restored.
+func (sub *mqttSubscription) check(open bool) {
+	sub.lock.RLock()
+	defer sub.lock.RUnlock()
+
+	if sub.conn == nil {
+		return
+	}
+	if was, _ := sub.connected.Get(); open == was {
+		return
+	}
+
+	if open && !sub.resubscribe() {
+		return // try again on next check
+	}
+	sub.connected.Set(open)
+}
+
+// restore subscribes again after the client reported that the connection has been restored.
+func (sub *mqttSubscription) restore() {
+	sub.lock.RLock()
+	defer sub.lock.RUnlock()
+
+	if sub.conn == nil {
+		return
+	}
+
+	if sub.resubscribe() {
+		sub.connected.Set(true)
+	}
+}
+
+func (sub *mqttSubscription) resubscribe() bool {
+	token := sub.conn.Subscribe(sub.filter, 1, sub.handler)
+	return token.WaitTimeout(mqttWatchInterval*4) && token.Error() == nil
+}
+
+// close stops following the connection and unsubscribes, waiting for the broker to acknowledge it
+// without holding the lock. The subscription is closed even if an error is returned.
+func (sub *mqttSubscription) close() error {
+	sub.lock.Lock()
+	conn := sub.conn
+	if conn == nil {
+		sub.lock.Unlock()
+		return nil
+	}
+
+	mqttSubscriptionsLock.Lock()
+	subs := mqttSubscriptions[conn]
+	for i, s := range subs {
+		if s == sub {
+			subs = append(subs[:i], subs[i+1:]...)
+			break
+		}
+	}
+	if len(subs) == 0 {
+		delete(mqttSubscriptions, conn)
+	} else {
+		mqttSubscriptions[conn] = subs
+	}
+	mqttSubscriptionsLock.Unlock()
+
+	close(sub.done)
+	sub.conn = nil
+	sub.lock.Unlock()
+
+	token := conn.Unsubscribe(sub.filter)
+	if !token.WaitTimeout(mqttWatchInterval * 4) {
+		return errUnsubscribeTimeout
+	}
+	return token.Error()
+}
@@ -0,0 +1,254 @@
+package binding
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MqttTopicTree is a data binding to all the topics matching an MQTT subscription filter.
+// Each level of a topic is a branch of the tree so it can be used directly with `widget.Tree`,
+// the value of a node being the latest payload received on that topic.
+type MqttTopicTree interface {
+	binding.StringTree
+	io.Closer
+
+	// Topics returns the list of topics discovered so far, sorted by name.
+	Topics() binding.StringList
+	// GetTopic returns a `String` binding to the value of a single topic matching the filter.
+	// Setting the returned binding publishes the new value on that topic.
+	GetTopic(topic string) (binding.String, error)
+	// Connected returns a `Bool` binding that turns false when the connection to the broker is lost
+	// and back to true once it has been restored and the filter subscribed again.
+	Connected() binding.Bool
+}
+
+type mqttTopicTree struct {
+	binding.StringTree
+	sub    *mqttSubscription
+	filter string
+
+	lock   sync.Mutex
+	topics binding.StringList
+	known  map[string]bool
+	items  map[string]*mqttTopicString
+}
+
+type mqttTopicString struct {
+	binding.String
+	tree  *mqttTopicTree
+	topic string
+}
+
+var (
+	errTopicNotMatching = errors.New("topic does not match the subscription filter")
+)
+
+// NewMqttTopicTree returns a `StringTree` binding to every topic matching the MQTT `filter`,
+// which can contain the `+` and `#` wildcards, on a connected mqtt.Client.
+// Topics are added to the tree as messages arrive and are removed when an empty message is
+// received on them, which is how a retained topic is cleared. Brokers forward such a clearing
+// message to the existing subscribers without the retained flag, so it is not required here.
+// The subscription is restored automatically when the client reconnects to the broker.
+// You should also call `Close()` on the binding once you are done to free the subscription.
+func NewMqttTopicTree(conn mqtt.Client, filter string) (MqttTopicTree, error) {
+	ret := &mqttTopicTree{
+		StringTree: binding.NewStringTree(),
+		filter:     filter,
+		topics:     binding.NewStringList(),
+		known:      make(map[string]bool),
+		items:      make(map[string]*mqttTopicString),
+	}
+
+	sub, err := newMqttSubscription(conn, filter, func(c mqtt.Client, m mqtt.Message) {
+		ret.received(m.Topic(), string(m.Payload()))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret.sub = sub
+	return ret, nil
+}
+
+func (t *mqttTopicTree) Connected() binding.Bool {
+	return t.sub.connected
+}
+
+func (t *mqttTopicTree) Topics() binding.StringList {
+	return t.topics
+}
+
+func (t *mqttTopicTree) GetTopic(topic string) (binding.String, error) {
+	if !mqttTopicMatches(t.filter, topic) {
+		return nil, errTopicNotMatching
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if item, ok := t.items[topic]; ok {
+		return item, nil
+	}
+
+	item := &mqttTopicString{String: binding.NewString(), tree: t, topic: topic}
+	if t.known[topic] {
+		val, _ := t.StringTree.GetValue(topic)
+		item.String.Set(val)
+	}
+	t.items[topic] = item
+
+	return item, nil
+}
+
+func (t *mqttTopicTree) Close() error {
+	return t.sub.close()
+}
+
+func (t *mqttTopicTree) received(topic, payload string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if payload == "" {
+		t.remove(topic)
+	} else {
+		t.update(topic, payload)
+	}
+
+	if item, ok := t.items[topic]; ok {
+		item.String.Set(payload)
+	}
+}
+
+// update sets the value of a topic, creating all the missing branches leading to it.
+func (t *mqttTopicTree) update(topic, payload string) {
+	if t.known[topic] {
+		t.StringTree.SetValue(topic, payload)
+		return
+	}
+
+	parent := binding.DataTreeRootID
+	for _, id := range mqttTopicBranches(topic) {
+		if _, err := t.StringTree.GetItem(id); err != nil {
+			t.StringTree.Append(parent, id, "")
+		}
+		parent = id
+	}
+	t.StringTree.SetValue(topic, payload)
+
+	t.known[topic] = true
+	t.topics.Set(t.sortedTopics())
+}
+
+// remove drops a topic from the tree as well as the branches that only existed to reach it.
+func (t *mqttTopicTree) remove(topic string) {
+	if !t.known[topic] {
+		return
+	}
+	delete(t.known, topic)
+
+	ids, values, _ := t.StringTree.Get()
+	tree := make(map[string][]string, len(ids))
+	for id, children := range ids {
+		tree[id] = append([]string{}, children...)
+	}
+	leaves := make(map[string]string, len(values))
+	for id, val := range values {
+		leaves[id] = val
+	}
+
+	branches := mqttTopicBranches(topic)
+	for i := len(branches) - 1; i >= 0; i-- {
+		id := branches[i]
+		if len(tree[id]) > 0 || (id != topic && t.known[id]) {
+			if id == topic { // still a branch to other topics
+				leaves[id] = ""
+			}
+			break
+		}
+
+		delete(tree, id)
+		delete(leaves, id)
+
+		parent := binding.DataTreeRootID
+		if i > 0 {
+			parent = branches[i-1]
+		}
+		tree[parent] = removeString(tree[parent], id)
+	}
+
+	t.StringTree.Set(tree, leaves)
+	t.topics.Set(t.sortedTopics())
+}
+
+func (t *mqttTopicTree) sortedTopics() []string {
+	list := make([]string, 0, len(t.known))
+	for topic := range t.known {
+		list = append(list, topic)
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+func (s *mqttTopicString) Set(val string) error {
+	return s.tree.sub.publish(s.topic, val)
+}
+
+// mqttTopicBranches returns the IDs of the tree nodes leading to a topic, the last one being
+// the topic itself. Empty levels at the start of a topic do not produce a node of their own.
+func mqttTopicBranches(topic string) []string {
+	levels := strings.Split(topic, "/")
+	ret := make([]string, 0, len(levels))
+	for i := range levels {
+		id := strings.Join(levels[:i+1], "/")
+		if strings.Trim(id, "/") == "" && i < len(levels)-1 {
+			continue
+		}
+		ret = append(ret, id)
+	}
+
+	return ret
+}
+
+// mqttTopicMatches reports whether a topic name is matched by a subscription filter
+// using the `+` (single level) and `#` (multi-level) MQTT wildcards.
+func mqttTopicMatches(filter, topic string) bool {
+	filters := strings.Split(filter, "/")
+	levels := strings.Split(topic, "/")
+
+	// topics starting with '$' are reserved and not matched by a leading wildcard
+	if strings.HasPrefix(topic, "$") && (filters[0] == "+" || filters[0] == "#") {
+		return false
+	}
+
+	for i, f := range filters {
+		if f == "#" {
+			return true
+		}
+		if i >= len(levels) {
+			return false
+		}
+		if f != "+" && f != levels[i] {
+			return false
+		}
+	}
+
+	return len(filters) == len(levels)
+}
+
+func removeString(list []string, s string) []string {
+	for i, item := range list {
+		if item == s {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,104 @@
+package binding
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMqttTopicMatches(t *testing.T) {
+	assert.True(t, mqttTopicMatches("sensors/+/temperature", "sensors/kitchen/temperature"))
+	assert.False(t, mqttTopicMatches("sensors/+/temperature", "sensors/kitchen/humidity"))
+	assert.False(t, mqttTopicMatches("sensors/+/temperature", "sensors/kitchen/oven/temperature"))
+	assert.True(t, mqttTopicMatches("plant/#", "plant"))
+	assert.True(t, mqttTopicMatches("plant/#", "plant/a/b/c"))
+	assert.False(t, mqttTopicMatches("plant/#", "plants/a"))
+	assert.True(t, mqttTopicMatches("#", "anything/at/all"))
+	assert.False(t, mqttTopicMatches("#", "$SYS/broker/uptime"))
+	assert.True(t, mqttTopicMatches("plant", "plant"))
+	assert.False(t, mqttTopicMatches("plant", "plant/a"))
+}
+
+func TestMqttTopicBranches(t *testing.T) {
+	assert.Equal(t, []string{"plant", "plant/a", "plant/a/b"}, mqttTopicBranches("plant/a/b"))
+	assert.Equal(t, []string{"/plant"}, mqttTopicBranches("/plant"))
+	assert.Equal(t, []string{"plant"}, mqttTopicBranches("plant"))
+}
+
+func TestMqttTopicTree_Received(t *testing.T) {
+	tree := &mqttTopicTree{
+		StringTree: binding.NewStringTree(),
+		filter:     "plant/#",
+		topics:     binding.NewStringList(),
+		known:      make(map[string]bool),
+		items:      make(map[string]*mqttTopicString),
+	}
+
+	tree.received("plant/a/temperature", "21")
+	tree.received("plant/a/humidity", "40")
+	tree.received("plant/b", "on")
+
+	assert.Equal(t, []string{"plant"}, tree.ChildIDs(binding.DataTreeRootID))
+	assert.Equal(t, []string{"plant/a", "plant/b"}, tree.ChildIDs("plant"))
+	assert.Equal(t, []string{"plant/a/temperature", "plant/a/humidity"}, tree.ChildIDs("plant/a"))
+
+	topics, err := tree.Topics().Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"plant/a/humidity", "plant/a/temperature", "plant/b"}, topics)
+
+	val, err := tree.GetValue("plant/a/temperature")
+	assert.NoError(t, err)
+	assert.Equal(t, "21", val)
+
+	item, err := tree.GetTopic("plant/b")
+	assert.NoError(t, err)
+	s, err := item.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "on", s)
+
+	tree.received("plant/b", "off")
+	s, err = item.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "off", s)
+
+	_, err = tree.GetTopic("garden/b")
+	assert.Error(t, err)
+
+	// an empty message clears the topic
+	tree.received("plant/a/humidity", "")
+	assert.Equal(t, []string{"plant/a/temperature"}, tree.ChildIDs("plant/a"))
+	tree.received("plant/a/temperature", "")
+	assert.Equal(t, []string{"plant/b"}, tree.ChildIDs("plant"))
+
+	topics, err = tree.Topics().Get()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"plant/b"}, topics)
+
+	tree.received("plant/b", "")
+	assert.Empty(t, tree.ChildIDs(binding.DataTreeRootID))
+}
+
+func TestMqttTopicTree_RemoveBranchTopic(t *testing.T) {
+	tree := &mqttTopicTree{
+		StringTree: binding.NewStringTree(),
+		filter:     "#",
+		topics:     binding.NewStringList(),
+		known:      make(map[string]bool),
+		items:      make(map[string]*mqttTopicString),
+	}
+
+	tree.received("plant", "main")
+	tree.received("plant/a", "1")
+
+	tree.received("plant", "")
+	assert.Equal(t, []string{"plant"}, tree.ChildIDs(binding.DataTreeRootID))
+	assert.Equal(t, []string{"plant/a"}, tree.ChildIDs("plant"))
+	val, err := tree.GetValue("plant")
+	assert.NoError(t, err)
+	assert.Equal(t, "", val)
+
+	tree.received("plant/a", "")
+	assert.Empty(t, tree.ChildIDs(binding.DataTreeRootID))
+}
@@ -0,0 +1,244 @@
+package binding
+
+import (
+	"os"
+	"strconv"
+	"sync"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// PreferenceOptions configures where a preference binding is stored and how it is seeded.
+type PreferenceOptions struct {
+	// Preferences stores the value, the preferences of the current application are used if nil.
+	Preferences fyne.Preferences
+	// Env is the name of an environment variable providing the value while the preference is not set.
+	Env string
+}
+
+type preference struct {
+	prefs fyne.Preferences
+	key   string
+
+	lock   sync.RWMutex
+	closed bool
+}
+
+type preferenceString struct {
+	binding.String
+	*preference
+	seed string
+}
+
+type preferenceFloat struct {
+	binding.Float
+	*preference
+	seed float64
+}
+
+type preferenceInt struct {
+	binding.Int
+	*preference
+	seed int
+}
+
+type preferenceBool struct {
+	binding.Bool
+	*preference
+	seed bool
+}
+
+// NewPreferenceString returns a `String` binding persisted in the preferences under `key`.
+// While the preference is not set, the binding holds the value of the environment variable
+// `opts.Env` if it is set, or `fallback` otherwise. Changes made to the preference elsewhere, for
+// example by another window, are reported to the listeners. `opts` can be nil to use the default options.
+// You should also call `Close()` on the binding once you are done to stop observing the preferences.
+func NewPreferenceString(key, fallback string, opts *PreferenceOptions) (StringCloser, error) {
+	pref, env, ok := newPreference(key, opts)
+	if ok {
+		fallback = env
+	}
+
+	ret := &preferenceString{String: binding.NewString(), preference: pref, seed: fallback}
+	ret.listen(ret.changed)
+	return ret, nil
+}
+
+// NewPreferenceFloat returns a `Float` binding persisted in the preferences under `key`, that is
+// seeded and observes changes in the same way as `NewPreferenceString`. An error is returned if the
+// environment variable can not be parsed.
+// You should also call `Close()` on the binding once you are done to stop observing the preferences.
+func NewPreferenceFloat(key string, fallback float64, opts *PreferenceOptions) (FloatCloser, error) {
+	pref, env, ok := newPreference(key, opts)
+	if ok {
+		f, err := strconv.ParseFloat(env, 64)
+		if err != nil {
+			return nil, err
+		}
+		fallback = f
+	}
+
+	ret := &preferenceFloat{Float: binding.NewFloat(), preference: pref, seed: fallback}
+	ret.listen(ret.changed)
+	return ret, nil
+}
+
+// NewPreferenceInt returns an `Int` binding persisted in the preferences under `key`, that is
+// seeded and observes changes in the same way as `NewPreferenceString`. An error is returned if the
+// environment variable can not be parsed.
+// You should also call `Close()` on the binding once you are done to stop observing the preferences.
+func NewPreferenceInt(key string, fallback int, opts *PreferenceOptions) (IntCloser, error) {
+	pref, env, ok := newPreference(key, opts)
+	if ok {
+		i, err := strconv.Atoi(env)
+		if err != nil {
+			return nil, err
+		}
+		fallback = i
+	}
+
+	ret := &preferenceInt{Int: binding.NewInt(), preference: pref, seed: fallback}
+	ret.listen(ret.changed)
+	return ret, nil
+}
+
+// NewPreferenceBool returns a `Bool` binding persisted in the preferences under `key`, that is
+// seeded and observes changes in the same way as `NewPreferenceString`. The environment variable
+// accepts the values understood by `strconv.ParseBool`, an error is returned otherwise.
+// You should also call `Close()` on the binding once you are done to stop observing the preferences.
+func NewPreferenceBool(key string, fallback bool, opts *PreferenceOptions) (BoolCloser, error) {
+	pref, env, ok := newPreference(key, opts)
+	if ok {
+		b, err := strconv.ParseBool(env)
+		if err != nil {
+			return nil, err
+		}
+		fallback = b
+	}
+
+	ret := &preferenceBool{Bool: binding.NewBool(), preference: pref, seed: fallback}
+	ret.listen(ret.changed)
+	return ret, nil
+}
+
+// newPreference returns the shared state of a preference binding, and the value of the environment
+// variable if it should be used to seed the binding.
+func newPreference(key string, opts *PreferenceOptions) (*preference, string, bool) {
+	if opts == nil {
+		opts = &PreferenceOptions{}
+	}
+
+	prefs := opts.Preferences
+	if prefs == nil {
+		prefs = fyne.CurrentApp().Preferences()
+	}
+	pref := &preference{prefs: prefs, key: key}
+
+	if opts.Env == "" {
+		return pref, "", false
+	}
+	env, ok := os.LookupEnv(opts.Env)
+	return pref, env, ok
+}
+
+// listen calls `changed` now and each time the preferences change, until the binding is closed.
+// Preferences do not support removing a change listener, so it is disabled instead.
+func (p *preference) listen(changed func()) {
+	changed()
+	p.prefs.AddChangeListener(func() {
+		p.lock.RLock()
+		closed := p.closed
+		p.lock.RUnlock()
+
+		if !closed {
+			changed()
+		}
+	})
+}
+
+func (p *preference) isClosed() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.closed
+}
+
+func (p *preference) Close() error {
+	p.lock.Lock()
+	p.closed = true
+	p.lock.Unlock()
+	return nil
+}
+
+func (s *preferenceString) Get() (string, error) {
+	return s.prefs.StringWithFallback(s.key, s.seed), nil
+}
+
+func (s *preferenceString) Set(val string) error {
+	if s.isClosed() {
+		return errBindingClosed
+	}
+
+	s.prefs.SetString(s.key, val)
+	return s.String.Set(val)
+}
+
+func (s *preferenceString) changed() {
+	val, _ := s.Get()
+	_ = s.String.Set(val) // we control s, Set will not error
+}
+
+func (f *preferenceFloat) Get() (float64, error) {
+	return f.prefs.FloatWithFallback(f.key, f.seed), nil
+}
+
+func (f *preferenceFloat) Set(val float64) error {
+	if f.isClosed() {
+		return errBindingClosed
+	}
+
+	f.prefs.SetFloat(f.key, val)
+	return f.Float.Set(val)
+}
+
+func (f *preferenceFloat) changed() {
+	val, _ := f.Get()
+	_ = f.Float.Set(val) // we control f, Set will not error
+}
+
+func (i *preferenceInt) Get() (int, error) {
+	return i.prefs.IntWithFallback(i.key, i.seed), nil
+}
+
+func (i *preferenceInt) Set(val int) error {
+	if i.isClosed() {
+		return errBindingClosed
+	}
+
+	i.prefs.SetInt(i.key, val)
+	return i.Int.Set(val)
+}
+
+func (i *preferenceInt) changed() {
+	val, _ := i.Get()
+	_ = i.Int.Set(val) // we control i, Set will not error
+}
+
+func (b *preferenceBool) Get() (bool, error) {
+	return b.prefs.BoolWithFallback(b.key, b.seed), nil
+}
+
+func (b *preferenceBool) Set(val bool) error {
+	if b.isClosed() {
+		return errBindingClosed
+	}
+
+	b.prefs.SetBool(b.key, val)
+	return b.Bool.Set(val)
+}
+
+func (b *preferenceBool) changed() {
+	val, _ := b.Get()
+	_ = b.Bool.Set(val) // we control b, Set will not error
+}
@@ -0,0 +1,76 @@
+package binding
+
+import (
+	"os"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPreferenceString(t *testing.T) {
+	a := test.NewApp()
+	defer a.Quit()
+
+	s, err := NewPreferenceString("name", "default", nil)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	v, _ := s.Get()
+	assert.Equal(t, "default", v)
+
+	assert.NoError(t, s.Set("saved"))
+	assert.Equal(t, "saved", a.Preferences().String("name"))
+
+	other, err := NewPreferenceString("name", "default", nil)
+	assert.NoError(t, err)
+	v, _ = other.Get()
+	assert.Equal(t, "saved", v)
+
+	// a change made elsewhere is reported
+	a.Preferences().SetString("name", "changed")
+	waitFor(t, stringEquals(s, "changed"))
+
+	assert.NoError(t, s.Close())
+	assert.Equal(t, errBindingClosed, s.Set("closed"))
+}
+
+func TestNewPreference_Env(t *testing.T) {
+	a := test.NewApp()
+	defer a.Quit()
+
+	os.Setenv("FYNE_X_TEST_PREF", "12")
+	defer os.Unsetenv("FYNE_X_TEST_PREF")
+	opts := &PreferenceOptions{Preferences: a.Preferences(), Env: "FYNE_X_TEST_PREF"}
+
+	i, err := NewPreferenceInt("count", 1, opts)
+	assert.NoError(t, err)
+	defer i.Close()
+	v, _ := i.Get()
+	assert.Equal(t, 12, v)
+
+	// the stored preference wins over the environment
+	assert.NoError(t, i.Set(3))
+	again, err := NewPreferenceInt("count", 1, opts)
+	assert.NoError(t, err)
+	defer again.Close()
+	v, _ = again.Get()
+	assert.Equal(t, 3, v)
+
+	f, err := NewPreferenceFloat("ratio", 0.5, opts)
+	assert.NoError(t, err)
+	defer f.Close()
+	fv, _ := f.Get()
+	assert.Equal(t, 12.0, fv)
+
+	_, err = NewPreferenceBool("enabled", false, opts)
+	assert.Error(t, err)
+
+	opts.Env = "FYNE_X_TEST_PREF_UNSET"
+	b, err := NewPreferenceBool("enabled", true, opts)
+	assert.NoError(t, err)
+	defer b.Close()
+	bv, _ := b.Get()
+	assert.True(t, bv)
+}
@@ -0,0 +1,248 @@
+package binding
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// sseDefaultRetry is the delay before reconnecting when the server did not send a `retry` field.
+const sseDefaultRetry = 3 * time.Second
+
+var (
+	errSSENoContent = errors.New("server asked to stop reconnecting")
+)
+
+type sseString struct {
+	binding.String
+	url    string
+	events map[string]bool
+
+	lock        sync.RWMutex
+	prev        error
+	lastEventID string
+	retry       time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSSEString returns a `String` binding to a Server-Sent Events stream at `url`, following the
+// EventSource protocol. The resulting string will be set to the data of the latest event received
+// of one of the `events` types, or of the default "message" type if none is specified.
+// When the connection is lost, the binding reconnects after the delay requested by the server and
+// sends the `Last-Event-ID` header so that the stream can be resumed.
+// You should also call `Close()` on the binding once you are done to free the connection.
+func NewSSEString(url string, events ...string) (StringCloser, error) {
+	ret := &sseString{String: binding.NewString(), url: url, events: make(map[string]bool),
+		retry: sseDefaultRetry, done: make(chan struct{})}
+	if len(events) == 0 {
+		events = []string{"message"}
+	}
+	for _, e := range events {
+		ret.events[e] = true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err := ret.connect(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	ret.cancel = cancel
+	go ret.readEvents(ctx, resp)
+	return ret, nil
+}
+
+func (s *sseString) Close() error {
+	s.lock.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.lock.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	<-s.done
+	return nil
+}
+
+func (s *sseString) Get() (string, error) {
+	s.lock.RLock()
+	err := s.prev
+	s.lock.RUnlock()
+	if err != nil {
+		return "", err
+	}
+
+	return s.String.Get()
+}
+
+func (s *sseString) connect(ctx context.Context) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if s.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", s.lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		resp.Body.Close()
+		return nil, errSSENoContent
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+	if media, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); media != "text/event-stream" {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected content type: %s", media)
+	}
+
+	return resp, nil
+}
+
+func (s *sseString) readEvents(ctx context.Context, resp *http.Response) {
+	defer close(s.done)
+
+	for {
+		err := s.parse(resp.Body)
+		resp.Body.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		s.setError(err)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.retry):
+			}
+
+			resp, err = s.connect(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			s.setError(err)
+			if err == nil {
+				break
+			}
+			if err == errSSENoContent {
+				return // permanent, the server does not want us to reconnect
+			}
+		}
+	}
+}
+
+// parse reads the event stream until it is closed, following the EventSource specification
+// https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+func (s *sseString) parse(r io.Reader) error {
+	var data strings.Builder
+	event, id := "", s.lastEventID
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanSSELines)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" { // dispatch
+			s.lastEventID = id
+			if data.Len() > 0 && s.events[eventOrDefault(event)] {
+				_ = s.String.Set(strings.TrimSuffix(data.String(), "\n")) // we control s, Set will not error
+				s.setError(nil)
+			}
+
+			data.Reset()
+			event = ""
+			continue
+		}
+		if line[0] == ':' { // comment
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				id = value
+			}
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+				s.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+
+	return scanner.Err()
+}
+
+func (s *sseString) setError(err error) {
+	s.lock.Lock()
+	s.prev = err
+	s.lock.Unlock()
+}
+
+func eventOrDefault(event string) string {
+	if event == "" {
+		return "message"
+	}
+	return event
+}
+
+// scanSSELines splits an event stream in lines, which can end with CRLF, LF or CR alone.
+func scanSSELines(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for i, b := range data {
+		if b == '\n' {
+			return i + 1, data[:i], nil
+		}
+		if b == '\r' {
+			if i+1 < len(data) {
+				if data[i+1] == '\n' {
+					return i + 2, data[:i], nil
+				}
+				return i + 1, data[:i], nil
+			}
+			if atEOF {
+				return i + 1, data[:i], nil
+			}
+			return 0, nil, nil // need to know if LF follows
+		}
+	}
+
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -0,0 +1,82 @@
+package binding
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSEString(t *testing.T) {
+	resumed := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		if id := r.Header.Get("Last-Event-ID"); id != "" {
+			resumed <- id
+			fmt.Fprint(w, "id: 3\ndata: resumed\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+
+		fmt.Fprint(w, "retry: 10\n: a comment\n\n")
+		fmt.Fprint(w, "id: 1\ndata: first\ndata: line\n\n")
+		fmt.Fprint(w, "id: 2\nevent: other\ndata: ignored\n\n")
+	}))
+	defer server.Close()
+
+	s, err := NewSSEString(server.URL)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, stringEquals(s, "first\nline"))
+	assert.Equal(t, "2", <-resumed)
+	waitFor(t, stringEquals(s, "resumed"))
+
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+}
+
+func TestSSEString_EventFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+		fmt.Fprint(w, "event: update\r\ndata: one\r\n\r\n")
+		fmt.Fprint(w, "data: default\r\r")
+		fmt.Fprint(w, "event: update\ndata:two\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	s, err := NewSSEString(server.URL, "update")
+	assert.NoError(t, err)
+	defer s.Close()
+
+	waitFor(t, stringEquals(s, "two"))
+}
+
+func TestSSEString_WrongContentType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+	}))
+	defer server.Close()
+
+	_, err := NewSSEString(server.URL)
+	assert.Error(t, err)
+}
+
+func TestScanSSELines(t *testing.T) {
+	lines := []string{}
+	data := []byte("a\r\nb\rc\n\nd")
+	for len(data) > 0 {
+		advance, token, err := scanSSELines(data, true)
+		assert.NoError(t, err)
+		lines = append(lines, string(token))
+		data = data[advance:]
+	}
+
+	assert.Equal(t, "a|b|c||d", strings.Join(lines, "|"))
+}
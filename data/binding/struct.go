@@ -0,0 +1,807 @@
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// StructValue supports binding a Go struct. Each exported field can be accessed as a data binding
+// named after the field, or after its `binding:"name"` tag. Fields tagged `binding:"-"` are ignored.
+type StructValue interface {
+	binding.DataItem
+
+	// Keys returns the names of the fields that can be bound, in declaration order.
+	Keys() []string
+	// GetItem returns the data binding matching the type of the field, as returned by the typed
+	// accessors below.
+	GetItem(key string) (binding.DataItem, error)
+
+	GetItemString(key string) (binding.String, error)
+	GetItemFloat(key string) (binding.Float, error)
+	GetItemInt(key string) (binding.Int, error)
+	GetItemBool(key string) (binding.Bool, error)
+	GetItemStruct(key string) (StructValue, error)
+
+	GetItemStringList(key string) (binding.StringList, error)
+	GetItemFloatList(key string) (binding.FloatList, error)
+	GetItemIntList(key string) (binding.IntList, error)
+	GetItemBoolList(key string) (binding.BoolList, error)
+	GetItemStructList(key string) (StructList, error)
+
+	// Reload notifies the listeners of the fields changed in the struct without using the bindings.
+	Reload() error
+}
+
+// StructList is a list binding to a slice of structs, each item being a `StructValue`.
+type StructList interface {
+	binding.DataList
+
+	// GetStruct returns the binding to the struct at `index`, as returned by `GetItem`.
+	GetStruct(index int) (StructValue, error)
+}
+
+type boundStruct struct {
+	lock   *sync.RWMutex // shared by all the bindings of the same struct
+	value  structValue
+	parent *boundStruct
+
+	self    binding.Int // counts the changes to notify listeners of the struct
+	changes int64
+
+	fields map[string]int
+	keys   []string
+	items  map[string]structItem
+}
+
+// structValue locates a value in the bound struct, while the struct is locked. It is located again
+// on each access, as the slices holding it may have been reallocated.
+type structValue func() (reflect.Value, error)
+
+// structItem is implemented by all the bindings to a field of a struct.
+type structItem interface {
+	binding.DataItem
+	reload()
+}
+
+type structField struct {
+	parent *boundStruct
+	value  structValue
+}
+
+type structString struct {
+	binding.String
+	structField
+}
+
+type structFloat struct {
+	binding.Float
+	structField
+}
+
+type structInt struct {
+	binding.Int
+	structField
+}
+
+type structBool struct {
+	binding.Bool
+	structField
+}
+
+// structList binds a slice of a struct, each item being bound like a field of the element type.
+// The typed lists below add the value accessors of the fyne lists, converted with reflection.
+type structList struct {
+	parent *boundStruct
+	value  structValue
+
+	self    binding.Int // counts the changes to notify listeners of the list
+	changes int64
+
+	items []structItem
+}
+
+type structStringList struct{ *structList }
+
+type structFloatList struct{ *structList }
+
+type structIntList struct{ *structList }
+
+type structBoolList struct{ *structList }
+
+type structStructList struct{ *structList }
+
+var (
+	errNotStructPointer = errors.New("a non nil pointer to a struct is required")
+	errNilStruct        = errors.New("nested struct pointer is nil")
+	errOutOfRange       = errors.New("value out of range for the field")
+	errIndexOutOfBounds = errors.New("index out of bounds")
+)
+
+// NewStructBinding returns a data binding to the struct pointed by `ptr`. Changes made through the
+// field bindings are written back into the struct while holding a lock shared by all the bindings
+// of the struct. Nested structs, and pointers to structs, are bound as `StructValue`, slices of
+// `string`, `float64`, `int` and `bool` as list bindings, and slices of structs as `StructList`.
+// If the struct is modified without using the bindings, call `Reload()` to notify the listeners.
+func NewStructBinding(ptr interface{}) (StructValue, error) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, errNotStructPointer
+	}
+
+	v = v.Elem()
+	return newBoundStruct(v.Type(), func() (reflect.Value, error) { return v, nil }, &sync.RWMutex{}, nil), nil
+}
+
+func newBoundStruct(t reflect.Type, v structValue, lock *sync.RWMutex, parent *boundStruct) *boundStruct {
+	ret := &boundStruct{lock: lock, value: v, parent: parent, self: binding.NewInt(),
+		fields: make(map[string]int), items: make(map[string]structItem)}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("binding"); ok {
+			if tag = strings.Split(tag, ",")[0]; tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+		}
+
+		ret.fields[name] = i
+		ret.keys = append(ret.keys, name)
+	}
+
+	return ret
+}
+
+func (b *boundStruct) AddListener(listener binding.DataListener) {
+	b.self.AddListener(listener)
+}
+
+func (b *boundStruct) RemoveListener(listener binding.DataListener) {
+	b.self.RemoveListener(listener)
+}
+
+func (b *boundStruct) Keys() []string {
+	return append([]string{}, b.keys...)
+}
+
+func (b *boundStruct) GetItem(key string) (binding.DataItem, error) {
+	return b.item(key, nil)
+}
+
+func (b *boundStruct) GetItemString(key string) (binding.String, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.String)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.String), nil
+}
+
+func (b *boundStruct) GetItemFloat(key string) (binding.Float, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.Float)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.Float), nil
+}
+
+func (b *boundStruct) GetItemInt(key string) (binding.Int, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.Int)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.Int), nil
+}
+
+func (b *boundStruct) GetItemBool(key string) (binding.Bool, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.Bool)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.Bool), nil
+}
+
+func (b *boundStruct) GetItemStruct(key string) (StructValue, error) {
+	item, err := b.item(key, reflect.TypeOf((*StructValue)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(StructValue), nil
+}
+
+func (b *boundStruct) GetItemStringList(key string) (binding.StringList, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.StringList)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.StringList), nil
+}
+
+func (b *boundStruct) GetItemFloatList(key string) (binding.FloatList, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.FloatList)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.FloatList), nil
+}
+
+func (b *boundStruct) GetItemIntList(key string) (binding.IntList, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.IntList)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.IntList), nil
+}
+
+func (b *boundStruct) GetItemBoolList(key string) (binding.BoolList, error) {
+	item, err := b.item(key, reflect.TypeOf((*binding.BoolList)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(binding.BoolList), nil
+}
+
+func (b *boundStruct) GetItemStructList(key string) (StructList, error) {
+	item, err := b.item(key, reflect.TypeOf((*StructList)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return item.(StructList), nil
+}
+
+func (b *boundStruct) Reload() error {
+	b.lock.RLock()
+	items := make([]structItem, 0, len(b.items))
+	for _, item := range b.items {
+		items = append(items, item)
+	}
+	b.lock.RUnlock()
+
+	for _, item := range items {
+		item.reload()
+	}
+	b.changed()
+	return nil
+}
+
+func (b *boundStruct) reload() {
+	_ = b.Reload() // never fails
+}
+
+// changed notifies the listeners of this struct, and of the structs containing it.
+func (b *boundStruct) changed() {
+	for s := b; s != nil; s = s.parent {
+		_ = s.self.Set(int(atomic.AddInt64(&s.changes, 1))) // we control self, Set will not error
+	}
+}
+
+// item returns the binding of the field `key`, creating it on first access.
+// If `want` is not nil, the binding has to implement that interface.
+func (b *boundStruct) item(key string, want reflect.Type) (binding.DataItem, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	item, ok := b.items[key]
+	if !ok {
+		i, ok := b.fields[key]
+		if !ok {
+			return nil, fmt.Errorf("no field bound to key %q", key)
+		}
+
+		var err error
+		item, err = b.bind(b.field(i))
+		if err != nil {
+			return nil, err
+		}
+		b.items[key] = item
+	}
+
+	if want != nil && !reflect.TypeOf(item).Implements(want) {
+		return nil, errWrongType
+	}
+	return item, nil
+}
+
+// bind returns the binding matching the type of `value`, that the struct has to be locked to locate.
+func (b *boundStruct) bind(value structValue) (structItem, error) {
+	v, err := value()
+	if err != nil {
+		return nil, err
+	}
+	field := structField{parent: b, value: value}
+
+	switch v.Kind() {
+	case reflect.String:
+		ret := &structString{String: binding.NewString(), structField: field}
+		_ = ret.String.Set(v.String()) // we control ret, Set will not error
+		return ret, nil
+	case reflect.Float32, reflect.Float64:
+		ret := &structFloat{Float: binding.NewFloat(), structField: field}
+		_ = ret.Float.Set(v.Float()) // we control ret, Set will not error
+		return ret, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		ret := &structInt{Int: binding.NewInt(), structField: field}
+		val, _ := intValue(v) // Get reports the values that do not fit an int
+		_ = ret.Int.Set(val)  // we control ret, Set will not error
+		return ret, nil
+	case reflect.Bool:
+		ret := &structBool{Bool: binding.NewBool(), structField: field}
+		_ = ret.Bool.Set(v.Bool()) // we control ret, Set will not error
+		return ret, nil
+	case reflect.Struct:
+		return newBoundStruct(v.Type(), value, b.lock, b), nil
+	case reflect.Ptr:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			break
+		}
+		if v.IsNil() {
+			return nil, errNilStruct
+		}
+		return newBoundStruct(v.Type().Elem(), func() (reflect.Value, error) {
+			v, err := value()
+			if err == nil && v.IsNil() {
+				err = errNilStruct
+			}
+			return v.Elem(), err
+		}, b.lock, b), nil
+	case reflect.Slice:
+		return b.bindSlice(v.Type(), value)
+	}
+
+	return nil, errWrongType
+}
+
+func (b *boundStruct) bindSlice(t reflect.Type, value structValue) (structItem, error) {
+	list := &structList{parent: b, value: value, self: binding.NewInt()}
+	if t.Elem().Kind() == reflect.Struct {
+		return structStructList{list}, nil
+	}
+	if t.Elem().PkgPath() != "" { // named element types can not be bound to fyne lists
+		return nil, errWrongType
+	}
+
+	switch t.Elem().Kind() {
+	case reflect.String:
+		return structStringList{list}, nil
+	case reflect.Float64:
+		return structFloatList{list}, nil
+	case reflect.Int:
+		return structIntList{list}, nil
+	case reflect.Bool:
+		return structBoolList{list}, nil
+	}
+
+	return nil, errWrongType
+}
+
+// field locates the field at index `i` of the struct.
+func (b *boundStruct) field(i int) structValue {
+	return func() (reflect.Value, error) {
+		v, err := b.value()
+		if err != nil {
+			return v, err
+		}
+		return v.Field(i), nil
+	}
+}
+
+// get returns the value of the field, read while the struct is locked.
+func (f *structField) get() (reflect.Value, error) {
+	f.parent.lock.RLock()
+	defer f.parent.lock.RUnlock()
+
+	return f.value()
+}
+
+// set writes `val` into the field while the struct is locked, and reports if the field changed.
+// It fails with errOutOfRange if `overflows` reports that the field can not hold the value.
+func (f *structField) set(val reflect.Value, overflows func(reflect.Value) bool) (bool, error) {
+	f.parent.lock.Lock()
+	defer f.parent.lock.Unlock()
+
+	v, err := f.value()
+	if err != nil {
+		return false, err
+	}
+	if overflows != nil && overflows(v) {
+		return false, errOutOfRange
+	}
+
+	val = val.Convert(v.Type())
+	if v.Interface() == val.Interface() {
+		return false, nil
+	}
+	v.Set(val)
+	return true, nil
+}
+
+func (s *structString) Get() (string, error) {
+	v, err := s.get()
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+func (s *structString) Set(val string) error {
+	changed, err := s.set(reflect.ValueOf(val), nil)
+	if changed {
+		_ = s.String.Set(val) // we control s, Set will not error
+		s.parent.changed()
+	}
+	return err
+}
+
+func (s *structString) reload() {
+	if val, err := s.Get(); err == nil {
+		_ = s.String.Set(val) // we control s, Set will not error
+	}
+}
+
+func (f *structFloat) Get() (float64, error) {
+	v, err := f.get()
+	if err != nil {
+		return 0, err
+	}
+	return v.Float(), nil
+}
+
+func (f *structFloat) Set(val float64) error {
+	changed, err := f.set(reflect.ValueOf(val), func(v reflect.Value) bool {
+		return v.OverflowFloat(val)
+	})
+	if changed {
+		_ = f.Float.Set(val) // we control f, Set will not error
+		f.parent.changed()
+	}
+	return err
+}
+
+func (f *structFloat) reload() {
+	if val, err := f.Get(); err == nil {
+		_ = f.Float.Set(val) // we control f, Set will not error
+	}
+}
+
+func (i *structInt) Get() (int, error) {
+	v, err := i.get()
+	if err != nil {
+		return 0, err
+	}
+	return intValue(v)
+}
+
+func (i *structInt) Set(val int) error {
+	changed, err := i.set(reflect.ValueOf(val), func(v reflect.Value) bool {
+		switch v.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return val < 0 || v.OverflowUint(uint64(val))
+		}
+		return v.OverflowInt(int64(val))
+	})
+	if changed {
+		_ = i.Int.Set(val) // we control i, Set will not error
+		i.parent.changed()
+	}
+	return err
+}
+
+func (i *structInt) reload() {
+	if val, err := i.Get(); err == nil {
+		_ = i.Int.Set(val) // we control i, Set will not error
+	}
+}
+
+// intValue returns the integer `v` as an int, or errOutOfRange if it does not fit.
+func intValue(v reflect.Value) (int, error) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := v.Uint(); u <= uint64(^uint(0)>>1) {
+			return int(u), nil
+		}
+		return 0, errOutOfRange
+	}
+
+	if i := v.Int(); int64(int(i)) == i {
+		return int(i), nil
+	}
+	return 0, errOutOfRange
+}
+
+func (b *structBool) Get() (bool, error) {
+	v, err := b.get()
+	if err != nil {
+		return false, err
+	}
+	return v.Bool(), nil
+}
+
+func (b *structBool) Set(val bool) error {
+	changed, err := b.set(reflect.ValueOf(val), nil)
+	if changed {
+		_ = b.Bool.Set(val) // we control b, Set will not error
+		b.parent.changed()
+	}
+	return err
+}
+
+func (b *structBool) reload() {
+	if val, err := b.Get(); err == nil {
+		_ = b.Bool.Set(val) // we control b, Set will not error
+	}
+}
+
+func (l *structList) AddListener(listener binding.DataListener) {
+	l.self.AddListener(listener)
+}
+
+func (l *structList) GetItem(index int) (binding.DataItem, error) {
+	l.parent.lock.Lock()
+	defer l.parent.lock.Unlock()
+
+	if v, err := l.value(); err != nil {
+		return nil, err
+	} else if index < 0 || index >= v.Len() {
+		return nil, errIndexOutOfBounds
+	}
+
+	for len(l.items) <= index {
+		l.items = append(l.items, nil)
+	}
+	if l.items[index] == nil {
+		item, err := l.parent.bind(l.index(index))
+		if err != nil {
+			return nil, err
+		}
+		l.items[index] = item
+	}
+	return l.items[index], nil
+}
+
+func (l *structList) Length() int {
+	l.parent.lock.RLock()
+	defer l.parent.lock.RUnlock()
+
+	v, err := l.value()
+	if err != nil {
+		return 0
+	}
+	return v.Len()
+}
+
+func (l *structList) RemoveListener(listener binding.DataListener) {
+	l.self.RemoveListener(listener)
+}
+
+func (l *structList) append(val reflect.Value) error {
+	return l.write(func(v reflect.Value) error {
+		v.Set(reflect.Append(v, val))
+		return nil
+	})
+}
+
+// get copies the slice into the slice pointed by `ptr`.
+func (l *structList) get(ptr interface{}) error {
+	l.parent.lock.RLock()
+	defer l.parent.lock.RUnlock()
+
+	v, err := l.value()
+	if err != nil {
+		return err
+	}
+	reflect.ValueOf(ptr).Elem().Set(reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, v.Len()), v))
+	return nil
+}
+
+// getValue copies the value at `index` into the value pointed by `ptr`.
+func (l *structList) getValue(index int, ptr interface{}) error {
+	l.parent.lock.RLock()
+	defer l.parent.lock.RUnlock()
+
+	v, err := l.index(index)()
+	if err != nil {
+		return err
+	}
+	reflect.ValueOf(ptr).Elem().Set(v)
+	return nil
+}
+
+// index locates the value at `index` in the slice.
+func (l *structList) index(index int) structValue {
+	return func() (reflect.Value, error) {
+		v, err := l.value()
+		if err != nil {
+			return v, err
+		}
+		if index < 0 || index >= v.Len() {
+			return reflect.Value{}, errIndexOutOfBounds
+		}
+		return v.Index(index), nil
+	}
+}
+
+func (l *structList) prepend(val reflect.Value) error {
+	return l.write(func(v reflect.Value) error {
+		v.Set(reflect.AppendSlice(reflect.Append(reflect.MakeSlice(v.Type(), 0, v.Len()+1), val), v))
+		return nil
+	})
+}
+
+func (l *structList) reload() {
+	l.parent.lock.RLock()
+	items := append([]structItem{}, l.items...)
+	l.parent.lock.RUnlock()
+
+	for _, item := range items {
+		if item != nil {
+			item.reload()
+		}
+	}
+	_ = l.self.Set(int(atomic.AddInt64(&l.changes, 1))) // we control self, Set will not error
+}
+
+func (l *structList) set(val reflect.Value) error {
+	return l.write(func(v reflect.Value) error {
+		v.Set(reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, val.Len()), val))
+		return nil
+	})
+}
+
+func (l *structList) setValue(index int, val reflect.Value) error {
+	return l.write(func(v reflect.Value) error {
+		if index < 0 || index >= v.Len() {
+			return errIndexOutOfBounds
+		}
+		v.Index(index).Set(val)
+		return nil
+	})
+}
+
+// write runs `fn` to change the slice while the struct is locked, then notifies the listeners of
+// the items, of the list and of the struct.
+func (l *structList) write(fn func(reflect.Value) error) error {
+	l.parent.lock.Lock()
+	v, err := l.value()
+	if err == nil {
+		err = fn(v)
+	}
+	l.parent.lock.Unlock()
+
+	if err == nil {
+		l.reload()
+		l.parent.changed()
+	}
+	return err
+}
+
+func (l structStringList) Append(val string) error {
+	return l.append(reflect.ValueOf(val))
+}
+
+func (l structStringList) Get() ([]string, error) {
+	var val []string
+	err := l.get(&val)
+	return val, err
+}
+
+func (l structStringList) GetValue(index int) (string, error) {
+	var val string
+	err := l.getValue(index, &val)
+	return val, err
+}
+
+func (l structStringList) Prepend(val string) error {
+	return l.prepend(reflect.ValueOf(val))
+}
+
+func (l structStringList) Set(val []string) error {
+	return l.set(reflect.ValueOf(val))
+}
+
+func (l structStringList) SetValue(index int, val string) error {
+	return l.setValue(index, reflect.ValueOf(val))
+}
+
+func (l structFloatList) Append(val float64) error {
+	return l.append(reflect.ValueOf(val))
+}
+
+func (l structFloatList) Get() ([]float64, error) {
+	var val []float64
+	err := l.get(&val)
+	return val, err
+}
+
+func (l structFloatList) GetValue(index int) (float64, error) {
+	var val float64
+	err := l.getValue(index, &val)
+	return val, err
+}
+
+func (l structFloatList) Prepend(val float64) error {
+	return l.prepend(reflect.ValueOf(val))
+}
+
+func (l structFloatList) Set(val []float64) error {
+	return l.set(reflect.ValueOf(val))
+}
+
+func (l structFloatList) SetValue(index int, val float64) error {
+	return l.setValue(index, reflect.ValueOf(val))
+}
+
+func (l structIntList) Append(val int) error {
+	return l.append(reflect.ValueOf(val))
+}
+
+func (l structIntList) Get() ([]int, error) {
+	var val []int
+	err := l.get(&val)
+	return val, err
+}
+
+func (l structIntList) GetValue(index int) (int, error) {
+	var val int
+	err := l.getValue(index, &val)
+	return val, err
+}
+
+func (l structIntList) Prepend(val int) error {
+	return l.prepend(reflect.ValueOf(val))
+}
+
+func (l structIntList) Set(val []int) error {
+	return l.set(reflect.ValueOf(val))
+}
+
+func (l structIntList) SetValue(index int, val int) error {
+	return l.setValue(index, reflect.ValueOf(val))
+}
+
+func (l structBoolList) Append(val bool) error {
+	return l.append(reflect.ValueOf(val))
+}
+
+func (l structBoolList) Get() ([]bool, error) {
+	var val []bool
+	err := l.get(&val)
+	return val, err
+}
+
+func (l structBoolList) GetValue(index int) (bool, error) {
+	var val bool
+	err := l.getValue(index, &val)
+	return val, err
+}
+
+func (l structBoolList) Prepend(val bool) error {
+	return l.prepend(reflect.ValueOf(val))
+}
+
+func (l structBoolList) Set(val []bool) error {
+	return l.set(reflect.ValueOf(val))
+}
+
+func (l structBoolList) SetValue(index int, val bool) error {
+	return l.setValue(index, reflect.ValueOf(val))
+}
+
+func (l structStructList) GetStruct(index int) (StructValue, error) {
+	item, err := l.GetItem(index)
+	if err != nil {
+		return nil, err
+	}
+	return item.(StructValue), nil
+}
@@ -0,0 +1,247 @@
+package binding
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testAddress struct {
+	City string `binding:"city"`
+	Zip  uint16
+}
+
+type testPerson struct {
+	Name    string `binding:"name"`
+	Age     int8
+	Height  float32
+	Admin   bool
+	Tags    []string
+	Scores  []int
+	Home    testAddress
+	Work    *testAddress
+	Places  []testAddress
+	Secret  string `binding:"-"`
+	private int
+}
+
+func TestNewStructBinding(t *testing.T) {
+	_, err := NewStructBinding(testPerson{})
+	assert.Error(t, err)
+	var nilPerson *testPerson
+	_, err = NewStructBinding(nilPerson)
+	assert.Error(t, err)
+
+	s, err := NewStructBinding(&testPerson{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"name", "Age", "Height", "Admin", "Tags", "Scores", "Home", "Work", "Places"}, s.Keys())
+
+	_, err = s.GetItem("Secret")
+	assert.Error(t, err)
+	_, err = s.GetItemString("Age")
+	assert.Error(t, err)
+	_, err = s.GetItem("Work")
+	assert.Error(t, err)
+}
+
+func TestStructBinding_Fields(t *testing.T) {
+	p := &testPerson{Name: "Ann", Age: 30, Height: 1.5}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+
+	name, err := s.GetItemString("name")
+	assert.NoError(t, err)
+	v, _ := name.Get()
+	assert.Equal(t, "Ann", v)
+	assert.NoError(t, name.Set("Bob"))
+	assert.Equal(t, "Bob", p.Name)
+
+	same, _ := s.GetItemString("name")
+	assert.Equal(t, name, same)
+
+	age, err := s.GetItemInt("Age")
+	assert.NoError(t, err)
+	assert.NoError(t, age.Set(42))
+	assert.Equal(t, int8(42), p.Age)
+	assert.Error(t, age.Set(300))
+	assert.Equal(t, int8(42), p.Age)
+
+	height, err := s.GetItemFloat("Height")
+	assert.NoError(t, err)
+	assert.NoError(t, height.Set(1.75))
+	assert.Equal(t, float32(1.75), p.Height)
+
+	admin, err := s.GetItemBool("Admin")
+	assert.NoError(t, err)
+	assert.NoError(t, admin.Set(true))
+	assert.True(t, p.Admin)
+}
+
+func TestStructBinding_LargeUint(t *testing.T) {
+	p := &struct{ Count uint64 }{Count: math.MaxUint64}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+
+	count, err := s.GetItemInt("Count")
+	assert.NoError(t, err)
+	_, err = count.Get()
+	assert.Equal(t, errOutOfRange, err)
+
+	assert.NoError(t, count.Set(7))
+	v, err := count.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, v)
+}
+
+func TestStructBinding_Nested(t *testing.T) {
+	p := &testPerson{Home: testAddress{City: "Paris"}, Work: &testAddress{City: "Lyon"}}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+
+	home, err := s.GetItemStruct("Home")
+	assert.NoError(t, err)
+	city, err := home.GetItemString("city")
+	assert.NoError(t, err)
+	assert.NoError(t, city.Set("Nice"))
+	assert.Equal(t, "Nice", p.Home.City)
+
+	zip, err := home.GetItemInt("Zip")
+	assert.NoError(t, err)
+	assert.Error(t, zip.Set(-1))
+	assert.NoError(t, zip.Set(6000))
+	assert.Equal(t, uint16(6000), p.Home.Zip)
+
+	work, err := s.GetItemStruct("Work")
+	assert.NoError(t, err)
+	city, err = work.GetItemString("city")
+	assert.NoError(t, err)
+	v, _ := city.Get()
+	assert.Equal(t, "Lyon", v)
+}
+
+func TestStructBinding_Lists(t *testing.T) {
+	p := &testPerson{Tags: []string{"a"}}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+
+	tags, err := s.GetItemStringList("Tags")
+	assert.NoError(t, err)
+	assert.NoError(t, tags.Append("b"))
+	assert.Equal(t, []string{"a", "b"}, p.Tags)
+
+	item, err := tags.GetItem(0)
+	assert.NoError(t, err)
+	assert.NoError(t, item.(interface{ Set(string) error }).Set("z"))
+	assert.Equal(t, []string{"z", "b"}, p.Tags)
+
+	scores, err := s.GetItemIntList("Scores")
+	assert.NoError(t, err)
+	assert.NoError(t, scores.Set([]int{1, 2}))
+	assert.Equal(t, []int{1, 2}, p.Scores)
+	assert.Equal(t, 2, scores.Length())
+}
+
+func TestStructBinding_ListItems(t *testing.T) {
+	p := &struct {
+		Weights []float64
+		Flags   []bool
+	}{Weights: []float64{1}}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+
+	weights, err := s.GetItemFloatList("Weights")
+	assert.NoError(t, err)
+	item, err := weights.GetItem(0)
+	assert.NoError(t, err)
+	for i := 0; i < 10; i++ { // the slice is reallocated
+		assert.NoError(t, weights.Prepend(float64(i)))
+	}
+	assert.NoError(t, item.(binding.Float).Set(2.5))
+	assert.Equal(t, 2.5, p.Weights[0])
+	v, err := weights.GetValue(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, v)
+	_, err = weights.GetItem(11)
+	assert.Error(t, err)
+
+	flags, err := s.GetItemBoolList("Flags")
+	assert.NoError(t, err)
+	var changes int32
+	flags.AddListener(binding.NewDataListener(func() { atomic.AddInt32(&changes, 1) }))
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) == 1 })
+	assert.NoError(t, flags.Set([]bool{true, false}))
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) == 2 })
+	assert.Equal(t, []bool{true, false}, p.Flags)
+
+	flag, err := flags.GetItem(1)
+	assert.NoError(t, err)
+	assert.NoError(t, flags.Set(nil))
+	_, err = flag.(binding.Bool).Get()
+	assert.Error(t, err)
+	assert.Error(t, flags.SetValue(0, true))
+}
+
+func TestStructBinding_StructList(t *testing.T) {
+	p := &testPerson{Places: []testAddress{{City: "Paris"}}}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+	_, err = s.GetItemStringList("Places")
+	assert.Error(t, err)
+
+	places, err := s.GetItemStructList("Places")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, places.Length())
+	place, err := places.GetStruct(0)
+	assert.NoError(t, err)
+	item, err := places.GetItem(0)
+	assert.NoError(t, err)
+	assert.Equal(t, place, item)
+
+	city, err := place.GetItemString("city")
+	assert.NoError(t, err)
+	assert.NoError(t, city.Set("Nice"))
+	assert.Equal(t, "Nice", p.Places[0].City)
+
+	var changes int32
+	s.AddListener(binding.NewDataListener(func() { atomic.AddInt32(&changes, 1) }))
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) == 1 })
+	p.Places = append(p.Places, testAddress{City: "Lyon"}) // reallocated
+	assert.NoError(t, s.Reload())
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) >= 2 })
+	assert.Equal(t, 2, places.Length())
+	assert.NoError(t, city.Set("Nantes"))
+	assert.Equal(t, "Nantes", p.Places[0].City)
+
+	second, err := places.GetStruct(1)
+	assert.NoError(t, err)
+	zip, err := second.GetItemInt("Zip")
+	assert.NoError(t, err)
+	assert.Error(t, zip.Set(69000))
+	assert.NoError(t, zip.Set(3465))
+	assert.Equal(t, uint16(3465), p.Places[1].Zip)
+	_, err = places.GetStruct(2)
+	assert.Error(t, err)
+}
+
+func TestStructBinding_Listeners(t *testing.T) {
+	p := &testPerson{Name: "Ann"}
+	s, err := NewStructBinding(p)
+	assert.NoError(t, err)
+	name, _ := s.GetItemString("name")
+	home, _ := s.GetItemStruct("Home")
+	city, _ := home.GetItemString("city")
+
+	p.Name = "Bob"
+	assert.NoError(t, s.Reload())
+	waitFor(t, stringEquals(name, "Bob"))
+
+	var changes int32
+	s.AddListener(binding.NewDataListener(func() { atomic.AddInt32(&changes, 1) }))
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) == 1 })
+	assert.NoError(t, city.Set("Nice"))
+	waitFor(t, func() bool { return atomic.LoadInt32(&changes) == 2 })
+}
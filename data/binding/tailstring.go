@@ -0,0 +1,268 @@
+package binding
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// tailPollInterval is the delay between two checks of a followed file.
+var tailPollInterval = 250 * time.Millisecond
+
+type fileTail struct {
+	path     string
+	received func([]string)
+
+	file    *os.File
+	info    os.FileInfo
+	offset  int64
+	partial []byte
+
+	lock sync.RWMutex
+	prev error
+	stop chan struct{}
+	done chan struct{}
+}
+
+type fileTailString struct {
+	binding.String
+	*fileTail
+}
+
+type fileTailList struct {
+	*lineList
+	*fileTail
+}
+
+// NewFileTailString returns a `String` binding set to the last line of the file at `path`, that
+// follows the lines appended to the file like `tail -F` does. When the file is truncated, or
+// replaced by another one as happens when logs are rotated, the new content is read from the start.
+// While the file does not exist, `Get` returns an error.
+// You should also call `Close()` on the binding once you are done to free the file.
+func NewFileTailString(path string) (StringCloser, error) {
+	ret := &fileTailString{String: binding.NewString()}
+
+	tail, err := startFileTail(path, 1, lastLine(ret.String))
+	if err != nil {
+		return nil, err
+	}
+
+	ret.fileTail = tail
+	return ret, nil
+}
+
+// NewFileTailList returns a `StringList` binding containing the last `lines` lines of the file at
+// `path`, or all of them if `lines` is 0, that follows the lines appended to the file in the same
+// way as `NewFileTailString`. An error is returned if `lines` is negative.
+// You should also call `Close()` on the binding once you are done to free the file.
+func NewFileTailList(path string, lines int) (StringListCloser, error) {
+	if lines < 0 {
+		return nil, errNegativeLines
+	}
+	ret := &fileTailList{lineList: newLineList(lines)}
+
+	tail, err := startFileTail(path, lines, ret.add)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.fileTail = tail
+	return ret, nil
+}
+
+func startFileTail(path string, lines int, received func([]string)) (*fileTail, error) {
+	t := &fileTail{path: path, received: received, stop: make(chan struct{}), done: make(chan struct{})}
+
+	f, err := os.Open(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	t.setError(err) // the file may appear later
+
+	if f != nil {
+		if err = t.open(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+
+		last, err := t.lastLines(lines)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		received(last)
+	}
+
+	go t.follow(t.stop)
+	return t, nil
+}
+
+func (t *fileTail) Close() error {
+	t.lock.Lock()
+	stop := t.stop
+	t.stop = nil
+	t.lock.Unlock()
+
+	if stop == nil {
+		return nil
+	}
+
+	close(stop)
+	<-t.done
+	return nil
+}
+
+func (t *fileTail) follow(stop chan struct{}) {
+	defer close(t.done)
+	defer func() {
+		if t.file != nil {
+			t.file.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(tailPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			t.setError(t.check())
+		}
+	}
+}
+
+// check reads what has been appended to the file, switching to a new file at the same path if needed.
+func (t *fileTail) check() error {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		if t.file != nil {
+			_ = t.read() // finish reading the file that was moved
+		}
+		return err
+	}
+
+	if t.file != nil && os.SameFile(info, t.info) {
+		if info.Size() < t.offset { // truncated
+			t.offset = 0
+			t.partial = nil
+		}
+		return t.read()
+	}
+
+	if t.file != nil {
+		_ = t.read()
+		t.file.Close()
+		t.file = nil
+	}
+
+	f, err := os.Open(t.path)
+	if err != nil {
+		return err
+	}
+	if err = t.open(f); err != nil {
+		f.Close()
+		return err
+	}
+	t.offset = 0
+	return t.read()
+}
+
+func (t *fileTail) open(f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	t.file, t.info = f, info
+	t.offset, t.partial = info.Size(), nil
+	return nil
+}
+
+// lastLines returns the last `count` complete lines already in the file, or all of them if `count`
+// is 0, keeping an incomplete last line to be completed by the next read.
+func (t *fileTail) lastLines(count int) ([]string, error) {
+	var content []byte
+	start := t.offset
+	for start > 0 && (count == 0 || bytes.Count(content, []byte{'\n'}) <= count) {
+		size := int64(4096)
+		if start < size {
+			size = start
+		}
+		start -= size
+
+		chunk := make([]byte, size)
+		if _, err := t.file.ReadAt(chunk, start); err != nil {
+			return nil, err
+		}
+		content = append(chunk, content...)
+	}
+
+	end := bytes.LastIndexByte(content, '\n')
+	t.partial = append([]byte{}, content[end+1:]...)
+	if end < 0 {
+		return nil, nil
+	}
+
+	lines := strings.Split(string(content[:end]), "\n")
+	if start > 0 {
+		lines = lines[1:] // the first line might not be complete
+	}
+	if count > 0 && len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+	return lines, nil
+}
+
+func (t *fileTail) read() error {
+	if _, err := t.file.Seek(t.offset, 0); err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(t.file)
+	if err != nil {
+		return err
+	}
+	t.offset += int64(len(data))
+
+	content := append(t.partial, data...)
+	end := bytes.LastIndexByte(content, '\n')
+	t.partial = append([]byte{}, content[end+1:]...)
+	if end >= 0 {
+		t.received(strings.Split(string(content[:end]), "\n"))
+	}
+	return nil
+}
+
+func (t *fileTail) setError(err error) {
+	t.lock.Lock()
+	t.prev = err
+	t.lock.Unlock()
+}
+
+func (t *fileTail) err() error {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.prev
+}
+
+func (s *fileTailString) Get() (string, error) {
+	if err := s.err(); err != nil {
+		return "", err
+	}
+
+	return s.String.Get()
+}
+
+func (l *fileTailList) Get() ([]string, error) {
+	if err := l.err(); err != nil {
+		return nil, err
+	}
+
+	return l.lineList.Get()
+}
@@ -0,0 +1,107 @@
+package binding
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func appendFile(t *testing.T, path, content string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	assert.NoError(t, err)
+	_, err = f.WriteString(content)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+}
+
+func TestFileTailString(t *testing.T) {
+	tailPollInterval = 10 * time.Millisecond
+	dir, err := ioutil.TempDir("", "fyne-x-tail")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.log")
+	appendFile(t, path, "first\nsecond\nincomp")
+
+	s, err := NewFileTailString(path)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	v, err := s.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "second", v)
+
+	appendFile(t, path, "lete\n")
+	waitFor(t, stringEquals(s, "incomplete"))
+
+	// rotate the log file
+	assert.NoError(t, os.Rename(path, path+".1"))
+	waitFor(t, func() bool {
+		_, err := s.Get()
+		return err != nil
+	})
+	appendFile(t, path, "rotated\n")
+	waitFor(t, stringEquals(s, "rotated"))
+
+	// truncate the log file
+	assert.NoError(t, os.Truncate(path, 0))
+	appendFile(t, path, "new\n")
+	waitFor(t, stringEquals(s, "new"))
+
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+}
+
+func TestFileTailList(t *testing.T) {
+	tailPollInterval = 10 * time.Millisecond
+	dir, err := ioutil.TempDir("", "fyne-x-tail")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.log")
+	s, err := NewFileTailList(path, 3)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	_, err = s.Get()
+	assert.Error(t, err)
+
+	appendFile(t, path, "1\n2\n")
+	waitFor(t, func() bool {
+		lines, err := s.Get()
+		return err == nil && reflect.DeepEqual([]string{"1", "2"}, lines)
+	})
+
+	appendFile(t, path, "3\n4\n5\n")
+	waitFor(t, func() bool {
+		lines, err := s.Get()
+		return err == nil && reflect.DeepEqual([]string{"3", "4", "5"}, lines)
+	})
+}
+
+func TestFileTailList_Unlimited(t *testing.T) {
+	tailPollInterval = 10 * time.Millisecond
+	dir, err := ioutil.TempDir("", "fyne-x-tail")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "app.log")
+	_, err = NewFileTailList(path, -1)
+	assert.Equal(t, errNegativeLines, err)
+
+	appendFile(t, path, "1\n2\n")
+	s, err := NewFileTailList(path, 0)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	appendFile(t, path, "3\n4\n5\n")
+	waitFor(t, func() bool {
+		lines, err := s.Get()
+		return err == nil && reflect.DeepEqual([]string{"1", "2", "3", "4", "5"}, lines)
+	})
+}
@@ -0,0 +1,44 @@
+package binding
+
+import (
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// Time is a data binding to a `time.Time` value, the zero time meaning no value.
+type Time interface {
+	binding.DataItem
+	Get() (time.Time, error)
+	Set(time.Time) error
+}
+
+type boundTime struct {
+	binding.Untyped
+
+	lock sync.Mutex // compares and sets the time at once
+}
+
+// NewTime returns a `Time` binding, set to the zero time.
+func NewTime() Time {
+	return &boundTime{Untyped: binding.NewUntyped()}
+}
+
+func (t *boundTime) Get() (time.Time, error) {
+	val, err := t.Untyped.Get()
+	if err != nil || val == nil {
+		return time.Time{}, err
+	}
+	return val.(time.Time), nil
+}
+
+func (t *boundTime) Set(val time.Time) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if prev, _ := t.Get(); prev.Equal(val) && prev.Location() == val.Location() {
+		return nil
+	}
+	return t.Untyped.Set(val)
+}
@@ -0,0 +1,58 @@
+package binding
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTime(t *testing.T) {
+	b := NewTime()
+	v, err := b.Get()
+	assert.NoError(t, err)
+	assert.True(t, v.IsZero())
+
+	var calls int32
+	b.AddListener(binding.NewDataListener(func() {
+		atomic.AddInt32(&calls, 1)
+	}))
+	waitFor(t, func() bool { return atomic.LoadInt32(&calls) == 1 })
+
+	date := time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+	assert.NoError(t, b.Set(date))
+	waitFor(t, func() bool { return atomic.LoadInt32(&calls) == 2 })
+	v, _ = b.Get()
+	assert.Equal(t, date, v)
+
+	assert.NoError(t, b.Set(time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestTime_ConcurrentSet(t *testing.T) {
+	b := NewTime()
+	var calls int32
+	b.AddListener(binding.NewDataListener(func() {
+		atomic.AddInt32(&calls, 1)
+	}))
+	waitFor(t, func() bool { return atomic.LoadInt32(&calls) == 1 })
+
+	date := time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, b.Set(date))
+		}()
+	}
+	wg.Wait()
+	waitFor(t, func() bool { return atomic.LoadInt32(&calls) == 2 })
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
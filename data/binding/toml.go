@@ -0,0 +1,467 @@
+package binding
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/BurntSushi/toml"
+)
+
+type tomlDocument struct {
+	text string
+	data map[string]interface{}
+}
+
+// tomlLocation is the position of a value in the lines of a TOML text.
+type tomlLocation struct {
+	found      bool
+	line       int
+	start, end int  // the columns of the value in the line
+	multiline  bool // the value continues on the next lines
+	inline     bool // the key is in an inline table
+	insertLine int  // where to add the key if it was not found, -1 if its table does not exist
+	dotted     bool // the table of the key is defined by dotted keys, in the table named by `section`
+	section    int  // the number of keys of the path naming the table holding the dotted keys
+}
+
+// tomlScanner follows the strings, arrays and inline tables of a value spanning multiple lines.
+type tomlScanner struct {
+	quote string // the delimiter of the string being read, if any
+	depth int    // nesting of arrays and inline tables
+}
+
+var (
+	errTOMLArray     = errors.New("values in TOML arrays can not be changed")
+	errTOMLMultiline = errors.New("TOML values spanning multiple lines can not be changed")
+	errTOMLInline    = errors.New("values in TOML inline tables can not be changed")
+	errTOMLTables    = errors.New("values in TOML arrays of tables can not be changed")
+)
+
+// NewTOMLFromString return a data binding to a TOML document synchronized with the `String` binding
+// used to create the new binding. It provides the same accessors as `NewJSONFromString`: the parameters
+// are the keys of tables, as strings, and the indexes of arrays, as ints.
+// When a value is changed, the line defining it is rewritten so that the comments and the order of
+// the keys of the document are preserved. New keys are added at the end of their table.
+func NewTOMLFromString(data binding.String) (JSONValue, error) {
+	return newDocument(data, parseTOML), nil
+}
+
+func parseTOML(s string) (document, error) {
+	doc := &tomlDocument{text: s, data: make(map[string]interface{})}
+	if _, err := toml.Decode(s, &doc.data); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (d *tomlDocument) isEmpty() bool {
+	return len(d.data) == 0
+}
+
+func (d *tomlDocument) get(path []interface{}) (interface{}, error) {
+	var val interface{} = d.data
+	for _, param := range path {
+		switch p := param.(type) {
+		case string:
+			table, ok := val.(map[string]interface{})
+			if !ok {
+				return nil, errWrongType
+			}
+			if val, ok = table[p]; !ok {
+				return nil, errPathNotFound
+			}
+		case int:
+			switch array := val.(type) {
+			case []interface{}:
+				if p < 0 || p >= len(array) {
+					return nil, errPathNotFound
+				}
+				val = array[p]
+			case []map[string]interface{}:
+				if p < 0 || p >= len(array) {
+					return nil, errPathNotFound
+				}
+				val = array[p]
+			default:
+				return nil, errWrongType
+			}
+		default:
+			return nil, fmt.Errorf("unsupported path parameter: %v", param)
+		}
+	}
+
+	switch val.(type) {
+	case string, int64, float64, bool:
+		return val, nil
+	}
+	return nil, errWrongType
+}
+
+func (d *tomlDocument) set(path []interface{}, val interface{}) (string, error) {
+	keys := make([]string, len(path))
+	for i, param := range path {
+		key, ok := param.(string)
+		if !ok {
+			return "", errTOMLArray
+		}
+		keys[i] = key
+	}
+	if d.inArrayOfTables(keys) {
+		return "", errTOMLTables
+	}
+
+	value, err := formatTOMLValue(val)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(d.text, "\n")
+	section := len(keys) - 1
+	loc := locateTOML(lines, keys, section)
+	if loc.dotted { // a header can not be added for the table, the key is added to the dotted keys
+		section = loc.section
+		loc = locateTOML(lines, keys, section)
+	}
+	switch {
+	case loc.inline:
+		return "", errTOMLInline
+	case loc.found && loc.multiline:
+		return "", errTOMLMultiline
+	case loc.found:
+		line := lines[loc.line]
+		lines[loc.line] = line[:loc.start] + value + line[loc.end:]
+	case loc.insertLine >= 0:
+		entry := formatTOMLKey(keys[section:]) + " = " + value
+		lines = append(lines[:loc.insertLine], append([]string{entry}, lines[loc.insertLine:]...)...)
+	default:
+		if last := len(lines) - 1; lines[last] == "" {
+			lines = lines[:last]
+		}
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "["+formatTOMLKey(keys[:len(keys)-1])+"]",
+			formatTOMLKey(keys[len(keys)-1:])+" = "+value, "")
+	}
+
+	text := strings.Join(lines, "\n")
+	updated, err := parseTOML(text)
+	if err != nil {
+		return "", err
+	}
+
+	*d = *updated.(*tomlDocument)
+	return text, nil
+}
+
+// inArrayOfTables returns whether the tables of `keys` go through an array of tables, whose
+// entries can not be told apart by keys.
+func (d *tomlDocument) inArrayOfTables(keys []string) bool {
+	var val interface{} = d.data
+	for _, key := range keys[:len(keys)-1] {
+		table, ok := val.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		val = table[key]
+		if _, ok := val.([]map[string]interface{}); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// locateTOML finds the line defining the key at `path`, and where it should be inserted otherwise,
+// in the table named by the first `section` keys of the path.
+func locateTOML(lines []string, path []string, section int) tomlLocation {
+	loc := tomlLocation{insertLine: -1}
+	table := path[:section]
+	current := []string{} // the table being read, nil for an array of tables
+	inTable := len(table) == 0
+	lastLine := 0 // the line after the last key of the table being read
+
+	scanner := &tomlScanner{}
+	for i, line := range lines {
+		if scanner.open() { // continuation of a multi-line value
+			scanner.scan(line, 0)
+			if inTable {
+				lastLine = i + 1
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed[0] == '#' {
+			continue
+		}
+
+		if trimmed[0] == '[' {
+			if inTable {
+				loc.insertLine = lastLine
+			}
+
+			current = nil
+			if !strings.HasPrefix(trimmed, "[[") {
+				if end := strings.LastIndexByte(trimmed, ']'); end > 0 {
+					current, _ = splitTOMLKey(trimmed[1:end])
+				}
+			}
+			inTable = current != nil && equalKeys(current, table)
+			if inTable {
+				lastLine = i + 1
+			}
+			continue
+		}
+
+		eq := tomlKeyEnd(line)
+		if eq < 0 {
+			continue
+		}
+		key, ok := splitTOMLKey(line[:eq])
+		start := eq + 1
+		for start < len(line) && (line[start] == ' ' || line[start] == '\t') {
+			start++
+		}
+		end := scanner.scan(line, start)
+		if inTable {
+			lastLine = i + 1
+		}
+
+		if ok && current != nil {
+			full := append(append([]string{}, current...), key...)
+			if equalKeys(full, path) {
+				return tomlLocation{found: true, line: i, start: start, end: end, multiline: scanner.open()}
+			}
+			if len(full) < len(path) && equalKeys(full, path[:len(full)]) && strings.HasPrefix(line[start:], "{") {
+				return tomlLocation{inline: true, insertLine: -1}
+			}
+			if !loc.dotted && len(current) < len(table) && len(table) < len(full) &&
+				equalKeys(full[:len(table)], table) {
+				loc.dotted, loc.section = true, len(current)
+			}
+		}
+	}
+
+	if inTable {
+		loc.insertLine = lastLine
+	}
+	return loc
+}
+
+// scan reads `line` from `start` and returns where the value ends, before any comment.
+func (sc *tomlScanner) scan(line string, start int) int {
+	i := start
+	for i < len(line) {
+		if sc.quote != "" {
+			switch {
+			case line[i] == '\\' && sc.quote[0] == '"':
+				i += 2
+			case strings.HasPrefix(line[i:], sc.quote):
+				i += len(sc.quote)
+				sc.quote = ""
+			default:
+				i++
+			}
+			continue
+		}
+
+		switch c := line[i]; {
+		case strings.HasPrefix(line[i:], `"""`) || strings.HasPrefix(line[i:], "'''"):
+			sc.quote = line[i : i+3]
+			i += 3
+		case c == '"' || c == '\'':
+			sc.quote = line[i : i+1]
+			i++
+		case c == '[' || c == '{':
+			sc.depth++
+			i++
+		case c == ']' || c == '}':
+			sc.depth--
+			i++
+		case c == '#':
+			return len(strings.TrimRight(line[:i], " \t"))
+		default:
+			i++
+		}
+	}
+
+	if len(sc.quote) == 1 { // single line strings can not continue
+		sc.quote = ""
+	}
+	return len(strings.TrimRight(line, " \t\r"))
+}
+
+func (sc *tomlScanner) open() bool {
+	return sc.quote != "" || sc.depth > 0
+}
+
+// tomlKeyEnd returns the index of the `=` separating the key from the value, -1 if there is none.
+func tomlKeyEnd(line string) int {
+	quote := byte(0)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '=':
+			return i
+		case c == '#':
+			return -1
+		}
+	}
+	return -1
+}
+
+// splitTOMLKey returns the parts of a dotted key, that can be bare or quoted.
+func splitTOMLKey(s string) ([]string, bool) {
+	var keys []string
+	s = strings.TrimSpace(s)
+	for s != "" {
+		var key string
+		switch s[0] {
+		case '"':
+			end := 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				return nil, false
+			}
+			unquoted, err := strconv.Unquote(s[:end+1])
+			if err != nil {
+				return nil, false
+			}
+			key, s = unquoted, s[end+1:]
+		case '\'':
+			end := strings.IndexByte(s[1:], '\'')
+			if end < 0 {
+				return nil, false
+			}
+			key, s = s[1:end+1], s[end+2:]
+		default:
+			end := 0
+			for end < len(s) && isBareTOMLKey(s[end]) {
+				end++
+			}
+			if end == 0 {
+				return nil, false
+			}
+			key, s = s[:end], s[end:]
+		}
+
+		keys = append(keys, key)
+		s = strings.TrimSpace(s)
+		if s != "" {
+			if s[0] != '.' {
+				return nil, false
+			}
+			s = strings.TrimSpace(s[1:])
+		}
+	}
+	return keys, len(keys) > 0
+}
+
+func isBareTOMLKey(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func formatTOMLKey(keys []string) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key
+		for j := 0; j < len(key); j++ {
+			if !isBareTOMLKey(key[j]) {
+				parts[i] = quoteTOML(key)
+				break
+			}
+		}
+		if key == "" {
+			parts[i] = `""`
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func formatTOMLValue(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return quoteTOML(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			return "nan", nil
+		case math.IsInf(v, 1):
+			return "inf", nil
+		case math.IsInf(v, -1):
+			return "-inf", nil
+		}
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") { // would be read as an integer
+			s += ".0"
+		}
+		return s, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	return "", errWrongType
+}
+
+// quoteTOML returns `s` as a TOML basic string.
+func quoteTOML(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
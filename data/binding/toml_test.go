@@ -0,0 +1,171 @@
+package binding
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTOML = `# application settings
+title = "Demo" # shown in the window
+
+[server]
+host = "localhost"
+port = 8080 # default port
+ratio = 0.5
+secure = true
+users = [
+  "alice",
+  "bob",
+]
+
+[client]
+retries = 3
+`
+
+func TestNewTOMLFromString(t *testing.T) {
+	s := binding.NewString()
+	doc, err := NewTOMLFromString(s)
+	assert.NoError(t, err)
+	assert.True(t, doc.IsEmpty())
+
+	title, _ := doc.GetItemString("title")
+	port, _ := doc.GetItemInt("server", "port")
+	ratio, _ := doc.GetItemFloat("server", "ratio")
+	secure, _ := doc.GetItemBool("server", "secure")
+	user, _ := doc.GetItemString("server", "users", 1)
+
+	s.Set(testTOML)
+	waitFor(t, stringEquals(user, "bob"))
+	assert.False(t, doc.IsEmpty())
+	v, _ := title.Get()
+	assert.Equal(t, "Demo", v)
+	i, _ := port.Get()
+	assert.Equal(t, 8080, i)
+	f, _ := ratio.Get()
+	assert.Equal(t, 0.5, f)
+	b, _ := secure.Get()
+	assert.True(t, b)
+
+	assert.Error(t, user.Set("carol"))
+	users, _ := doc.GetItemString("server", "users")
+	waitFor(t, func() bool {
+		_, err := users.Get()
+		return err != nil
+	})
+}
+
+func TestTOML_Set(t *testing.T) {
+	s := binding.NewString()
+	s.Set(testTOML)
+	doc, err := NewTOMLFromString(s)
+	assert.NoError(t, err)
+
+	title, _ := doc.GetItemString("title")
+	waitFor(t, stringEquals(title, "Demo"))
+	assert.NoError(t, title.Set(`Say "hi"`))
+	port, _ := doc.GetItemInt("server", "port")
+	assert.NoError(t, port.Set(443))
+	ratio, _ := doc.GetItemFloat("server", "ratio")
+	assert.NoError(t, ratio.Set(2))
+	timeout, _ := doc.GetItemInt("server", "timeout")
+	assert.NoError(t, timeout.Set(30))
+	debug, _ := doc.GetItemBool("logging", "debug")
+	assert.NoError(t, debug.Set(true))
+	name, _ := doc.GetItemString("version")
+	assert.NoError(t, name.Set("1.0"))
+
+	text, _ := s.Get()
+	assert.Equal(t, `# application settings
+title = "Say \"hi\"" # shown in the window
+version = "1.0"
+
+[server]
+host = "localhost"
+port = 443 # default port
+ratio = 2.0
+secure = true
+users = [
+  "alice",
+  "bob",
+]
+timeout = 30
+
+[client]
+retries = 3
+
+[logging]
+debug = true
+`, text)
+}
+
+func TestTOML_SetUnsupported(t *testing.T) {
+	s := binding.NewString()
+	s.Set(`point = { x = 1, y = 2 }
+
+[[servers]]
+host = "alpha"
+
+[[servers]]
+host = "beta"
+`)
+	doc, err := NewTOMLFromString(s)
+	assert.NoError(t, err)
+
+	x, _ := doc.GetItemInt("point", "x")
+	waitFor(t, func() bool {
+		v, err := x.Get()
+		return err == nil && v == 1
+	})
+	assert.Equal(t, errTOMLInline, x.Set(3))
+	z, _ := doc.GetItemInt("point", "z")
+	assert.Equal(t, errTOMLInline, z.Set(3))
+
+	host, _ := doc.GetItemString("servers", "host")
+	assert.Equal(t, errTOMLTables, host.Set("gamma"))
+	first, _ := doc.GetItemString("servers", 0, "host")
+	assert.Equal(t, errTOMLArray, first.Set("gamma"))
+}
+
+func TestTOML_SetDottedTable(t *testing.T) {
+	s := binding.NewString()
+	s.Set(`a.b = 1
+
+[c]
+d.e = 2
+`)
+	doc, err := NewTOMLFromString(s)
+	assert.NoError(t, err)
+
+	b, _ := doc.GetItemInt("a", "b")
+	waitFor(t, func() bool {
+		v, err := b.Get()
+		return err == nil && v == 1
+	})
+	ac, _ := doc.GetItemInt("a", "c")
+	assert.NoError(t, ac.Set(3))
+	cdf, _ := doc.GetItemInt("c", "d", "f")
+	assert.NoError(t, cdf.Set(4))
+
+	text, _ := s.Get()
+	assert.Equal(t, `a.b = 1
+a.c = 3
+
+[c]
+d.e = 2
+d.f = 4
+`, text)
+}
+
+func TestTOMLKeys(t *testing.T) {
+	keys, ok := splitTOMLKey(` a . "b.c" . 'd' `)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b.c", "d"}, keys)
+	_, ok = splitTOMLKey("a b")
+	assert.False(t, ok)
+
+	assert.Equal(t, `a."b c"`, formatTOMLKey([]string{"a", "b c"}))
+	assert.Equal(t, `"tab\there\u0001"`, quoteTOML("tab\there\x01"))
+}
@@ -0,0 +1,177 @@
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlDocument struct {
+	root   yaml.Node
+	indent int
+}
+
+// NewYAMLFromString return a data binding to a YAML document synchronized with the `String` binding
+// used to create the new binding. It provides the same accessors as `NewJSONFromString`: the parameters
+// are the keys of mappings, as strings, and the indexes of sequences, as ints.
+// When a value is changed, the comments and the order of the keys of the document are preserved.
+func NewYAMLFromString(data binding.String) (JSONValue, error) {
+	return newDocument(data, parseYAML), nil
+}
+
+func parseYAML(s string) (document, error) {
+	doc := &yamlDocument{indent: yamlIndent(s)}
+	if err := yaml.Unmarshal([]byte(s), &doc.root); err != nil {
+		return nil, err
+	}
+
+	if doc.root.Kind == 0 { // empty text
+		doc.root = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	return doc, nil
+}
+
+// yamlIndent returns the indentation used by a YAML text, so that it is kept when encoding it again.
+func yamlIndent(s string) int {
+	indent := 0
+	for _, line := range strings.Split(s, "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || trimmed[0] == '#' {
+			continue
+		}
+		if n := len(line) - len(trimmed); n > 0 && (indent == 0 || n < indent) {
+			indent = n
+		}
+	}
+
+	if indent == 0 {
+		return 2
+	}
+	return indent
+}
+
+func (d *yamlDocument) isEmpty() bool {
+	if len(d.root.Content) == 0 {
+		return true
+	}
+
+	top := resolveYAML(d.root.Content[0])
+	return top.Kind != yaml.ScalarNode && len(top.Content) == 0
+}
+
+func (d *yamlDocument) get(path []interface{}) (interface{}, error) {
+	node, err := d.lookup(path, false)
+	if err != nil {
+		return nil, err
+	}
+	if node.Kind != yaml.ScalarNode {
+		return nil, errWrongType
+	}
+
+	var val interface{}
+	if err = node.Decode(&val); err != nil {
+		return nil, err
+	}
+	if i, ok := val.(int); ok {
+		return int64(i), nil
+	}
+	return val, nil
+}
+
+func (d *yamlDocument) set(path []interface{}, val interface{}) (string, error) {
+	node, err := d.lookup(path, true)
+	if err != nil {
+		return "", err
+	}
+	if node.Kind != yaml.ScalarNode && len(node.Content) > 0 {
+		return "", errWrongType
+	}
+
+	node.Kind = yaml.ScalarNode
+	switch v := val.(type) {
+	case string:
+		node.Tag, node.Value = "!!str", v
+		if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 && !strings.Contains(v, "\n") {
+			node.Style = 0
+		}
+	case int64:
+		node.Tag, node.Value, node.Style = "!!int", strconv.FormatInt(v, 10), 0
+	case float64:
+		node.Tag, node.Value, node.Style = "!!float", strconv.FormatFloat(v, 'g', -1, 64), 0
+	case bool:
+		node.Tag, node.Value, node.Style = "!!bool", strconv.FormatBool(v), 0
+	default:
+		return "", errWrongType
+	}
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(d.indent)
+	if err = enc.Encode(&d.root); err != nil {
+		return "", err
+	}
+	if err = enc.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// lookup returns the node at `path`, the missing mapping keys are added if `create` is true.
+func (d *yamlDocument) lookup(path []interface{}, create bool) (*yaml.Node, error) {
+	node := resolveYAML(d.root.Content[0])
+	for i, param := range path {
+		switch p := param.(type) {
+		case string:
+			if node.Kind != yaml.MappingNode {
+				return nil, errWrongType
+			}
+
+			next := yamlMappingValue(node, p)
+			if next == nil {
+				if !create {
+					return nil, errPathNotFound
+				}
+
+				next = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+				if i == len(path)-1 {
+					next = &yaml.Node{Kind: yaml.ScalarNode}
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: p}, next)
+			}
+			node = resolveYAML(next)
+		case int:
+			if node.Kind != yaml.SequenceNode {
+				return nil, errWrongType
+			}
+			if p < 0 || p >= len(node.Content) {
+				return nil, errPathNotFound
+			}
+			node = resolveYAML(node.Content[p])
+		default:
+			return nil, fmt.Errorf("unsupported path parameter: %v", param)
+		}
+	}
+
+	return node, nil
+}
+
+func yamlMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func resolveYAML(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
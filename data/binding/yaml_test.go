@@ -0,0 +1,100 @@
+package binding
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testYAML = `# server settings
+server:
+  host: localhost # the name
+  port: 8080
+  ratio: 0.5
+  secure: true
+users:
+  - alice
+  - bob
+`
+
+func TestNewYAMLFromString(t *testing.T) {
+	s := binding.NewString()
+	doc, err := NewYAMLFromString(s)
+	assert.NoError(t, err)
+	assert.True(t, doc.IsEmpty())
+
+	host, err := doc.GetItemString("server", "host")
+	assert.NoError(t, err)
+	port, err := doc.GetItemInt("server", "port")
+	assert.NoError(t, err)
+	ratio, err := doc.GetItemFloat("server", "ratio")
+	assert.NoError(t, err)
+	secure, err := doc.GetItemBool("server", "secure")
+	assert.NoError(t, err)
+	user, err := doc.GetItemString("users", 1)
+	assert.NoError(t, err)
+
+	s.Set(testYAML)
+	waitFor(t, stringEquals(user, "bob"))
+	assert.False(t, doc.IsEmpty())
+	v, _ := host.Get()
+	assert.Equal(t, "localhost", v)
+	i, _ := port.Get()
+	assert.Equal(t, 8080, i)
+	f, _ := ratio.Get()
+	assert.Equal(t, 0.5, f)
+	b, _ := secure.Get()
+	assert.True(t, b)
+
+	missing, err := doc.GetItemString("server", "missing")
+	assert.NoError(t, err)
+	waitFor(t, func() bool {
+		_, err := missing.Get()
+		return err != nil
+	})
+}
+
+func TestYAML_Set(t *testing.T) {
+	s := binding.NewString()
+	s.Set(testYAML)
+	doc, err := NewYAMLFromString(s)
+	assert.NoError(t, err)
+
+	host, _ := doc.GetItemString("server", "host")
+	waitFor(t, stringEquals(host, "localhost"))
+	assert.NoError(t, host.Set("example.com"))
+	port, _ := doc.GetItemInt("server", "port")
+	assert.NoError(t, port.Set(443))
+	name, _ := doc.GetItemString("server", "name")
+	assert.NoError(t, name.Set("123"))
+
+	text, _ := s.Get()
+	assert.Equal(t, `# server settings
+server:
+  host: example.com # the name
+  port: 443
+  ratio: 0.5
+  secure: true
+  name: "123"
+users:
+  - alice
+  - bob
+`, text)
+}
+
+func TestYAML_Invalid(t *testing.T) {
+	s := binding.NewString()
+	s.Set("key: [unclosed")
+	doc, err := NewYAMLFromString(s)
+	assert.NoError(t, err)
+
+	key, _ := doc.GetItemString("key")
+	waitFor(t, func() bool {
+		_, err := key.Get()
+		return err != nil
+	})
+	assert.True(t, doc.IsEmpty())
+	assert.Error(t, key.Set("value"))
+}
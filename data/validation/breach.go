@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+)
+
+// BreachList is a list of breached passwords stored in a file as SHA-1 hashes, such as the
+// offline dump of Have I Been Pwned. Each line contains an hexadecimal hash, optionally followed
+// by a colon and the number of times it was seen, and the lines must be sorted by hash.
+// The file is searched without being loaded in memory, so that large lists can be used.
+type BreachList struct {
+	file *os.File
+	size int64
+}
+
+// OpenBreachList opens the list of breached passwords stored in the file at `path`.
+// You should call `Close()` on the list once you are done to free the file.
+func OpenBreachList(path string) (*BreachList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &BreachList{file: f, size: info.Size()}, nil
+}
+
+// Close frees the file of the list.
+func (l *BreachList) Close() error {
+	return l.file.Close()
+}
+
+// Contains reports if `password` is in the list.
+func (l *BreachList) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// binary search of the line starting with the hash, the start of which is in [low, high)
+	low, high := int64(0), l.size
+	for low < high {
+		mid := low + (high-low)/2
+		line, start, err := l.lineAfter(mid)
+		if err != nil {
+			return false, err
+		}
+		if line == nil || start >= high {
+			high = mid
+			continue
+		}
+
+		found := strings.ToUpper(string(bytes.SplitN(line, []byte{':'}, 2)[0]))
+		switch {
+		case found == hash:
+			return true, nil
+		case found < hash:
+			low = start + int64(len(line)) + 1
+		default:
+			high = mid
+		}
+	}
+	return false, nil
+}
+
+// lineAfter returns the first line starting at or after `pos`, and its start, or a nil line at the end of the file.
+func (l *BreachList) lineAfter(pos int64) ([]byte, int64, error) {
+	start := pos
+	if pos > 0 {
+		skipped, err := l.readLine(pos - 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		start = pos - 1 + int64(len(skipped)) + 1
+	}
+	if start >= l.size {
+		return nil, start, nil
+	}
+
+	line, err := l.readLine(start)
+	return bytes.TrimSuffix(line, []byte{'\r'}), start, err
+}
+
+// readLine returns the content from `pos` to the end of the line, without the line break.
+func (l *BreachList) readLine(pos int64) ([]byte, error) {
+	var line []byte
+	buf := make([]byte, 128)
+	for {
+		n, err := l.file.ReadAt(buf, pos)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return append(line, buf[:i]...), nil
+		}
+		line = append(line, buf[:n]...)
+		pos += int64(n)
+
+		if err == io.EOF {
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
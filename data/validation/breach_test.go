@@ -0,0 +1,61 @@
+package validation_test
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeBreachList(t *testing.T, passwords []string, lineEnd string) string {
+	var lines []string
+	for i, p := range passwords {
+		sum := sha1.Sum([]byte(p))
+		lines = append(lines, fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), i+1))
+	}
+	sort.Strings(lines)
+
+	dir, err := ioutil.TempDir("", "breach")
+	assert.NoError(t, err)
+	path := filepath.Join(dir, "pwned.txt")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(strings.Join(lines, lineEnd)+lineEnd), 0644))
+	return path
+}
+
+func TestBreachList(t *testing.T) {
+	var passwords []string
+	for i := 0; i < 500; i++ {
+		passwords = append(passwords, fmt.Sprintf("password%d", i))
+	}
+
+	for _, lineEnd := range []string{"\n", "\r\n"} {
+		path := writeBreachList(t, passwords, lineEnd)
+		defer os.RemoveAll(filepath.Dir(path))
+
+		list, err := validation.OpenBreachList(path)
+		assert.NoError(t, err)
+		for _, p := range passwords {
+			found, err := list.Contains(p)
+			assert.NoError(t, err)
+			assert.True(t, found, p)
+		}
+
+		found, err := list.Contains("not breached")
+		assert.NoError(t, err)
+		assert.False(t, found)
+
+		v := validation.NewPasswordPolicy().NotBreached(list).Validator()
+		assert.Error(t, v("password42"))
+		assert.NoError(t, v("Correct-H0rse"))
+		assert.NoError(t, list.Close())
+	}
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	"fyne.io/fyne/v2"
+
+	"fyne.io/x/fyne/lang"
+)
+
+// All returns a validator accepting a text only if all the `validators` accept it.
+// The error of the first validator rejecting the text is returned.
+func All(validators ...fyne.StringValidator) fyne.StringValidator {
+	return func(text string) error {
+		for _, v := range validators {
+			if err := v(text); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Any returns a validator accepting a text if at least one of the `validators` accepts it.
+// If they all reject the text, their errors are combined.
+func Any(validators ...fyne.StringValidator) fyne.StringValidator {
+	return func(text string) error {
+		messages := make([]string, 0, len(validators))
+		for _, v := range validators {
+			err := v(text)
+			if err == nil {
+				return nil
+			}
+			messages = append(messages, err.Error())
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+		return errors.New(strings.Join(messages, lang.Localize(" or ")))
+	}
+}
+
+// Not returns a validator accepting a text only if `validator` rejects it. As the inverted
+// validator has no explanation to give, `message` is returned as error, after being localized.
+func Not(validator fyne.StringValidator, message string) fyne.StringValidator {
+	return func(text string) error {
+		if validator(text) == nil {
+			return newError(message)
+		}
+		return nil
+	}
+}
+
+// Optional returns a validator accepting an empty text, or a text accepted by `validator`.
+func Optional(validator fyne.StringValidator) fyne.StringValidator {
+	return func(text string) error {
+		if text == "" {
+			return nil
+		}
+		return validator(text)
+	}
+}
@@ -0,0 +1,40 @@
+package validation_test
+
+import (
+	"testing"
+
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	v := validation.All(validation.NewLength(3, -1), validation.NewOneOf("abc", "ab"))
+
+	assert.NoError(t, v("abc"))
+	assert.EqualError(t, v("ab"), "must be at least 3 characters long")
+	assert.Error(t, v("abcd"))
+}
+
+func TestAny(t *testing.T) {
+	v := validation.Any(validation.NewIPv4(), validation.NewHostname())
+
+	assert.NoError(t, v("10.0.0.1"))
+	assert.NoError(t, v("example.com"))
+	assert.EqualError(t, v("bad host"), "not a valid IPv4 address or not a valid host name")
+}
+
+func TestNot(t *testing.T) {
+	v := validation.Not(validation.NewOneOf("admin", "root"), "this name is reserved")
+
+	assert.NoError(t, v("user"))
+	assert.EqualError(t, v("root"), "this name is reserved")
+}
+
+func TestOptional(t *testing.T) {
+	v := validation.Optional(validation.NewEmail())
+
+	assert.NoError(t, v(""))
+	assert.NoError(t, v("user@example.com"))
+	assert.Error(t, v("user"))
+}
@@ -0,0 +1,348 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ErrPending is the validation error of a field while its asynchronous validators are running.
+var ErrPending = errors.New("validation in progress")
+
+// AsyncValidator is a validator that can take time, such as checking that a user name is available
+// on a server. It should stop and return early when `ctx` is cancelled, which happens when the text
+// changes before the validation is completed.
+type AsyncValidator func(ctx context.Context, text string) error
+
+// Form groups the fields of a form, so that their validators can depend on other fields and run
+// asynchronously. The validation result of each field is reported to its `widget.Entry`.
+type Form struct {
+	lock    sync.RWMutex
+	fields  []*FormField
+	valid   binding.Bool
+	pending binding.Bool
+}
+
+// FormField is a field of a `Form`, created by `Form.Add`.
+type FormField struct {
+	form       *Form
+	data       binding.String
+	entry      *widget.Entry
+	validators []fyne.StringValidator
+	listener   binding.DataListener
+	result     binding.Untyped // passes the results of the validations to publish
+
+	lock       sync.Mutex
+	err        error
+	async      []AsyncValidator
+	delay      time.Duration
+	depends    []binding.DataItem
+	asyncText  string
+	asyncErr   error
+	asyncState int // one of asyncIdle, asyncRunning or asyncDone
+	timer      *time.Timer
+	cancel     context.CancelFunc
+	pending    binding.Bool
+}
+
+// validationResult is a result of the validation of a field, passed to `FormField.publish`.
+type validationResult struct {
+	err error
+}
+
+const (
+	asyncIdle = iota
+	asyncRunning
+	asyncDone
+)
+
+// NewForm returns an empty form, add fields to it with `Add`.
+func NewForm() *Form {
+	return &Form{valid: binding.NewBool(), pending: binding.NewBool()}
+}
+
+// Add adds a field holding its text in `data`, accepted only if all the `validators` accept it.
+// Validators depending on other fields can read their bindings, and `DependsOn` should be called so
+// that the field is validated again when they change. If `entry` is not nil, it is bound to `data`
+// and its validation state follows the result of the field validation.
+func (f *Form) Add(data binding.String, entry *widget.Entry, validators ...fyne.StringValidator) *FormField {
+	field := &FormField{form: f, data: data, entry: entry, validators: validators,
+		result: binding.NewUntyped(), pending: binding.NewBool()}
+	if entry != nil {
+		entry.Bind(data)
+		entry.Validator = field.entryValidator
+	}
+	field.result.AddListener(binding.NewDataListener(field.publish))
+
+	f.lock.Lock()
+	f.fields = append(f.fields, field)
+	f.lock.Unlock()
+
+	field.listener = binding.NewDataListener(field.revalidate)
+	data.AddListener(field.listener)
+	return field
+}
+
+// Close stops the validation of all the fields, cancelling the asynchronous validators running.
+func (f *Form) Close() error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	for _, field := range f.fields {
+		field.close()
+	}
+	return nil
+}
+
+// Pending returns a `Bool` binding reporting if asynchronous validators are running for some fields.
+func (f *Form) Pending() binding.Bool {
+	return f.pending
+}
+
+// Valid returns a `Bool` binding reporting if all the fields are valid. It is false while
+// asynchronous validators are running, and can be used to enable the submit button.
+func (f *Form) Valid() binding.Bool {
+	return f.valid
+}
+
+// Validate validates all the fields with their current value, and returns the first error found.
+// `ErrPending` is returned if asynchronous validators are still running.
+func (f *Form) Validate() error {
+	f.lock.RLock()
+	fields := append([]*FormField{}, f.fields...)
+	f.lock.RUnlock()
+
+	var first error
+	for _, field := range fields {
+		if err := field.Validate(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// update computes the state of the form from the results of its fields.
+func (f *Form) update() {
+	f.lock.RLock()
+	valid, pending := true, false
+	for _, field := range f.fields {
+		err := field.Err()
+		valid = valid && err == nil
+		pending = pending || err == ErrPending
+	}
+	f.lock.RUnlock()
+
+	// we control the bindings, Set will not error
+	_ = f.valid.Set(valid)
+	_ = f.pending.Set(pending)
+}
+
+// AddAsync adds an asynchronous validator, that runs once the text did not change for `delay`
+// and all the other validators accept it. The longest delay is used for all the asynchronous
+// validators of the field.
+func (f *FormField) AddAsync(delay time.Duration, validator AsyncValidator) *FormField {
+	f.lock.Lock()
+	f.async = append(f.async, validator)
+	if delay > f.delay {
+		f.delay = delay
+	}
+	f.stopAsync()
+	f.asyncState = asyncIdle
+	f.lock.Unlock()
+
+	f.revalidate()
+	return f
+}
+
+// DependsOn validates the field again each time one of the `items` changes, so that validators
+// can compare the field with other fields.
+func (f *FormField) DependsOn(items ...binding.DataItem) *FormField {
+	f.lock.Lock()
+	f.depends = append(f.depends, items...)
+	f.lock.Unlock()
+
+	for _, item := range items {
+		item.AddListener(f.listener)
+	}
+	return f
+}
+
+// Err returns the result of the latest validation of the field, `ErrPending` while asynchronous
+// validators are running. The result is updated asynchronously, after the entry of the field.
+func (f *FormField) Err() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.err
+}
+
+// Pending returns a `Bool` binding reporting if asynchronous validators are running for the field.
+func (f *FormField) Pending() binding.Bool {
+	return f.pending
+}
+
+// Validate validates the current value of the field and returns the result.
+func (f *FormField) Validate() error {
+	text, err := f.data.Get()
+	if err == nil {
+		err = f.check(text)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err == nil {
+		err = f.checkAsync(text)
+	} else {
+		f.stopAsync()
+		f.asyncState = asyncIdle
+	}
+	_ = f.result.Set(&validationResult{err}) // we control result, Set will not error
+	return err
+}
+
+func (f *FormField) close() {
+	f.data.RemoveListener(f.listener)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, item := range f.depends {
+		item.RemoveListener(f.listener)
+	}
+	f.stopAsync()
+	f.asyncState = asyncIdle
+}
+
+// entryValidator is the validator installed in the entry, it returns the result of the latest
+// validation of the field instead of validating the text again.
+func (f *FormField) entryValidator(string) error {
+	return f.Err()
+}
+
+// publish reports the latest result of the validation to the entry, the field and the form. It runs
+// in the goroutine of the data bindings, so that the entry is updated from a single goroutine.
+func (f *FormField) publish() {
+	value, _ := f.result.Get()
+	result, ok := value.(*validationResult)
+	if !ok {
+		return
+	}
+
+	if f.entry != nil {
+		f.entry.SetValidationError(result.err)
+	}
+	f.lock.Lock()
+	f.err = result.err
+	f.lock.Unlock()
+	_ = f.pending.Set(result.err == ErrPending) // we control pending, Set will not error
+	f.form.update()
+}
+
+// revalidate validates the field when its value or one of its dependencies changed.
+func (f *FormField) revalidate() {
+	_ = f.Validate() // published to the entry and the form
+}
+
+// check runs the synchronous validators.
+func (f *FormField) check(text string) error {
+	for _, v := range f.validators {
+		if err := v(text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAsync schedules the asynchronous validators for the `text`, unless they already ran for it,
+// the field must be locked.
+func (f *FormField) checkAsync(text string) error {
+	if len(f.async) == 0 {
+		return nil
+	}
+	if f.asyncState != asyncIdle && f.asyncText == text {
+		if f.asyncState == asyncRunning {
+			return ErrPending
+		}
+		return f.asyncErr
+	}
+
+	f.stopAsync()
+	ctx, cancel := context.WithCancel(context.Background())
+	f.asyncText, f.asyncState, f.cancel = text, asyncRunning, cancel
+	validators := append([]AsyncValidator{}, f.async...)
+	f.timer = time.AfterFunc(f.delay, func() {
+		f.runAsync(ctx, text, validators)
+	})
+	return ErrPending
+}
+
+func (f *FormField) runAsync(ctx context.Context, text string, validators []AsyncValidator) {
+	var err error
+	for _, v := range validators {
+		if err = v(ctx, text); err != nil {
+			break
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if ctx.Err() != nil || f.asyncText != text || f.asyncState != asyncRunning { // cancelled
+		return
+	}
+	f.asyncState, f.asyncErr = asyncDone, err
+	f.cancel = nil
+	_ = f.result.Set(&validationResult{err}) // we control result, Set will not error
+}
+
+// stopAsync cancels the asynchronous validation scheduled or running, the field must be locked.
+func (f *FormField) stopAsync() {
+	if f.timer != nil {
+		f.timer.Stop()
+		f.timer = nil
+	}
+	if f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+}
+
+// NewEqualTo returns a validator accepting only the current value of the `other` binding, such as
+// a password confirmation field. The field should depend on `other` with `FormField.DependsOn`.
+func NewEqualTo(other binding.String) fyne.StringValidator {
+	return func(text string) error {
+		val, err := other.Get()
+		if err != nil {
+			return err
+		}
+		if text != val {
+			return newError("does not match")
+		}
+		return nil
+	}
+}
+
+// NewDateAfter returns a validator accepting a date formatted with `layout` that is after the date
+// held by the `other` binding, such as the end of a period. If `other` does not hold a valid date,
+// only the format of the date is checked.
+func NewDateAfter(other binding.String, layout string) fyne.StringValidator {
+	return func(text string) error {
+		date, err := time.Parse(layout, text)
+		if err != nil {
+			return newError("not a valid date, expected %s", layout)
+		}
+
+		val, err := other.Get()
+		if err != nil {
+			return err
+		}
+		if start, err := time.Parse(layout, val); err == nil && !date.After(start) {
+			return newError("must be after %s", val)
+		}
+		return nil
+	}
+}
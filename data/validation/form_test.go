@@ -0,0 +1,156 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForErr(t *testing.T, f *validation.FormField, expected error) {
+	for i := 0; i < 100; i++ {
+		if err := f.Err(); err == expected || err != nil && expected != nil && err.Error() == expected.Error() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, expected, f.Err())
+}
+
+func waitForBool(t *testing.T, b binding.Bool, expected bool) {
+	for i := 0; i < 100; i++ {
+		if v, _ := b.Get(); v == expected {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	v, _ := b.Get()
+	assert.Equal(t, expected, v)
+}
+
+// entryErrors records the validation errors reported to an entry, that are set in the goroutine of
+// the data bindings.
+type entryErrors struct {
+	lock sync.Mutex
+	err  error
+}
+
+func newEntryErrors(entry *widget.Entry) *entryErrors {
+	e := &entryErrors{}
+	entry.SetOnValidationChanged(func(err error) {
+		e.lock.Lock()
+		e.err = err
+		e.lock.Unlock()
+	})
+	return e
+}
+
+func (e *entryErrors) wait(t *testing.T, expected error) {
+	for i := 0; i < 100; i++ {
+		e.lock.Lock()
+		err := e.err
+		e.lock.Unlock()
+		if err == expected || err != nil && expected != nil && err.Error() == expected.Error() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	assert.Equal(t, expected, e.err)
+}
+
+func TestForm_CrossField(t *testing.T) {
+	test.NewApp()
+	password, confirm := binding.NewString(), binding.NewString()
+	entry := widget.NewEntry()
+	test.WidgetRenderer(entry)
+	entryErr := newEntryErrors(entry)
+
+	form := validation.NewForm()
+	defer form.Close()
+	form.Add(password, nil, validation.NewLength(4, -1))
+	field := form.Add(confirm, entry, validation.NewEqualTo(password)).DependsOn(password)
+
+	_ = password.Set("secret")
+	_ = confirm.Set("secret")
+	waitForErr(t, field, nil)
+	assert.Nil(t, form.Validate())
+
+	_ = password.Set("changed")
+	waitForErr(t, field, errors.New("does not match"))
+	entryErr.wait(t, errors.New("does not match"))
+	waitForBool(t, form.Valid(), false)
+
+	_ = confirm.Set("changed")
+	waitForErr(t, field, nil)
+	entryErr.wait(t, nil)
+	waitForBool(t, form.Valid(), true)
+}
+
+func TestForm_Async(t *testing.T) {
+	test.NewApp()
+	name := binding.NewString()
+	entry := widget.NewEntry()
+	test.WidgetRenderer(entry)
+	entryErr := newEntryErrors(entry)
+	var calls, cancelled int32
+
+	form := validation.NewForm()
+	defer form.Close()
+	field := form.Add(name, entry, validation.NewLength(1, -1)).
+		AddAsync(20*time.Millisecond, func(ctx context.Context, text string) error {
+			atomic.AddInt32(&calls, 1)
+			select {
+			case <-ctx.Done():
+				atomic.AddInt32(&cancelled, 1)
+				return ctx.Err()
+			case <-time.After(50 * time.Millisecond):
+			}
+			if text == "taken" {
+				return errors.New("not available")
+			}
+			return nil
+		})
+
+	assert.Error(t, field.Validate()) // empty, async validators do not run
+	_ = name.Set("slow")
+	waitForErr(t, field, validation.ErrPending)
+	_ = name.Set("taken") // debounced, "slow" is not checked
+	waitForErr(t, field, errors.New("not available"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	entryErr.wait(t, errors.New("not available"))
+
+	_ = name.Set("free")
+	waitForErr(t, field, validation.ErrPending)
+	waitForBool(t, form.Pending(), true)
+	time.Sleep(40 * time.Millisecond) // started
+	_ = name.Set("other")
+	waitForErr(t, field, nil)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&cancelled))
+	waitForBool(t, field.Pending(), false)
+	waitForBool(t, form.Valid(), true)
+}
+
+func TestNewDateAfter(t *testing.T) {
+	start := binding.NewString()
+	_ = start.Set("2024-03-01")
+	v := validation.NewDateAfter(start, "2006-01-02")
+
+	assert.NoError(t, v("2024-03-02"))
+	assert.Error(t, v("2024-03-01"))
+	assert.Error(t, v("2024-02-28"))
+	assert.Error(t, v("tomorrow"))
+
+	_ = start.Set("")
+	assert.NoError(t, v("2024-02-28"))
+}
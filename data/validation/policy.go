@@ -0,0 +1,180 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// PasswordPolicy is a set of rules that passwords have to follow. Rules are added by calling the
+// methods of the policy, that can be chained:
+//
+//	policy := validation.NewPasswordPolicy().MinLength(12).RequireDigit().NoRepeats(3)
+//	entry.Validator = policy.Validator()
+type PasswordPolicy struct {
+	rules []func(string) error
+}
+
+// NewPasswordPolicy returns a policy without any rule, to be completed by calling its methods.
+func NewPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{}
+}
+
+// Validator returns a validator accepting the passwords following all the rules of the policy.
+// The error of the first rule that is not followed is returned.
+func (p *PasswordPolicy) Validator() fyne.StringValidator {
+	return func(text string) error {
+		if errs := p.check(text); len(errs) > 0 {
+			return errs[0]
+		}
+		return nil
+	}
+}
+
+// MinLength requires passwords to have at least `n` characters.
+func (p *PasswordPolicy) MinLength(n int) *PasswordPolicy {
+	return p.add(func(text string) error {
+		if len([]rune(text)) < n {
+			return newError("use at least %d characters", n)
+		}
+		return nil
+	})
+}
+
+// RequireLowercase requires passwords to contain a lowercase letter.
+func (p *PasswordPolicy) RequireLowercase() *PasswordPolicy {
+	return p.requireClass(unicode.IsLower, "add a lowercase letter")
+}
+
+// RequireUppercase requires passwords to contain an uppercase letter.
+func (p *PasswordPolicy) RequireUppercase() *PasswordPolicy {
+	return p.requireClass(unicode.IsUpper, "add an uppercase letter")
+}
+
+// RequireDigit requires passwords to contain a digit.
+func (p *PasswordPolicy) RequireDigit() *PasswordPolicy {
+	return p.requireClass(unicode.IsDigit, "add a digit")
+}
+
+// RequireSymbol requires passwords to contain a character that is not a letter or a digit.
+func (p *PasswordPolicy) RequireSymbol() *PasswordPolicy {
+	return p.requireClass(isSymbol, "add a symbol")
+}
+
+// MinClasses requires passwords to contain characters of at least `n` of the classes: lowercase
+// letters, uppercase letters, digits and symbols.
+func (p *PasswordPolicy) MinClasses(n int) *PasswordPolicy {
+	return p.add(func(text string) error {
+		count := 0
+		for _, class := range []func(rune) bool{unicode.IsLower, unicode.IsUpper, unicode.IsDigit, isSymbol} {
+			if strings.IndexFunc(text, class) >= 0 {
+				count++
+			}
+		}
+
+		if count < n {
+			return newError("mix at least %d of lowercase letters, uppercase letters, digits and symbols", n)
+		}
+		return nil
+	})
+}
+
+// NotContaining rejects passwords containing the value of one of the `data` bindings, such as the
+// user name or email address entered in the same form. The comparison ignores the case, and for
+// an email address its local part is also checked. Values shorter than 3 characters are ignored.
+func (p *PasswordPolicy) NotContaining(data ...binding.String) *PasswordPolicy {
+	return p.add(func(text string) error {
+		lower := strings.ToLower(text)
+		for _, d := range data {
+			val, err := d.Get()
+			if err != nil {
+				continue
+			}
+
+			values := []string{val}
+			if at := strings.LastIndexByte(val, '@'); at > 0 {
+				values = append(values, val[:at])
+			}
+			for _, v := range values {
+				if len([]rune(v)) >= 3 && strings.Contains(lower, strings.ToLower(v)) {
+					return newError("do not include your personal information")
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// NoRepeats rejects passwords with a character repeated more than `n` times in a row, like "aaaa",
+// or with a sequence of more than `n` consecutive characters, like "1234" or "dcba".
+func (p *PasswordPolicy) NoRepeats(n int) *PasswordPolicy {
+	return p.add(func(text string) error {
+		runes := []rune(text)
+		repeat, up, down := 1, 1, 1
+		for i := 1; i < len(runes); i++ {
+			repeat, up, down = nextCount(repeat, runes[i] == runes[i-1]),
+				nextCount(up, runes[i] == runes[i-1]+1), nextCount(down, runes[i] == runes[i-1]-1)
+
+			if repeat > n {
+				return newError("avoid repeating the same character")
+			}
+			if up > n || down > n {
+				return newError("avoid sequences like abc or 123")
+			}
+		}
+		return nil
+	})
+}
+
+// NotBreached rejects the passwords found in the `list` of breached passwords.
+// If the list can not be read, the password is rejected.
+func (p *PasswordPolicy) NotBreached(list *BreachList) *PasswordPolicy {
+	return p.add(func(text string) error {
+		found, err := list.Contains(text)
+		if err != nil {
+			return err
+		}
+		if found {
+			return newError("this password appeared in a data breach, choose another one")
+		}
+		return nil
+	})
+}
+
+func (p *PasswordPolicy) add(rule func(string) error) *PasswordPolicy {
+	p.rules = append(p.rules, rule)
+	return p
+}
+
+// check returns the errors of all the rules that are not followed.
+func (p *PasswordPolicy) check(text string) []error {
+	var errs []error
+	for _, rule := range p.rules {
+		if err := rule(text); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (p *PasswordPolicy) requireClass(class func(rune) bool, message string) *PasswordPolicy {
+	return p.add(func(text string) error {
+		if strings.IndexFunc(text, class) < 0 {
+			return newError(message)
+		}
+		return nil
+	})
+}
+
+func isSymbol(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func nextCount(count int, continued bool) int {
+	if continued {
+		return count + 1
+	}
+	return 1
+}
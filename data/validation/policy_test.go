@@ -0,0 +1,51 @@
+package validation_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	v := validation.NewPasswordPolicy().MinLength(8).RequireLowercase().RequireUppercase().
+		RequireDigit().RequireSymbol().Validator()
+
+	assert.NoError(t, v("Correct-H0rse"))
+	assert.EqualError(t, v("Short1!"), "use at least 8 characters")
+	assert.EqualError(t, v("correct-h0rse"), "add an uppercase letter")
+	assert.EqualError(t, v("Correct-Horse"), "add a digit")
+	assert.EqualError(t, v("CorrectH0rse"), "add a symbol")
+}
+
+func TestPasswordPolicy_MinClasses(t *testing.T) {
+	v := validation.NewPasswordPolicy().MinClasses(3).Validator()
+
+	assert.NoError(t, v("horse Battery"))
+	assert.Error(t, v("horsebattery1"))
+}
+
+func TestPasswordPolicy_NotContaining(t *testing.T) {
+	user, email := binding.NewString(), binding.NewString()
+	user.Set("jdoe")
+	email.Set("john.smith@example.com")
+	v := validation.NewPasswordPolicy().NotContaining(user, email).Validator()
+
+	assert.NoError(t, v("unrelated words"))
+	assert.Error(t, v("my-JDoe-password"))
+	assert.Error(t, v("john.smith!!"))
+
+	user.Set("jo")
+	assert.NoError(t, v("jo jo jo"))
+}
+
+func TestPasswordPolicy_NoRepeats(t *testing.T) {
+	v := validation.NewPasswordPolicy().NoRepeats(3).Validator()
+
+	assert.NoError(t, v("aaa-123-cba"))
+	assert.EqualError(t, v("baaaad"), "avoid repeating the same character")
+	assert.EqualError(t, v("x1234"), "avoid sequences like abc or 123")
+	assert.EqualError(t, v("zyxw"), "avoid sequences like abc or 123")
+}
@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"io"
+
+	"fyne.io/fyne/v2/data/binding"
+	gpv "github.com/wagslane/go-password-validator"
+
+	"fyne.io/x/fyne/lang"
+)
+
+// entropyScores are the minimum entropies of the scores 1 to 4 of a password strength.
+var entropyScores = []float64{28, 36, 60, 128}
+
+// PasswordStrength observes a password and rates its strength, so that a meter can be displayed.
+type PasswordStrength interface {
+	io.Closer
+
+	// Score returns an `Int` binding set to the strength of the password, from 0 for a very weak
+	// password to 4 for a strong one.
+	Score() binding.Int
+	// Suggestions returns a `StringList` binding containing the advice to strengthen the password.
+	Suggestions() binding.StringList
+}
+
+type passwordStrength struct {
+	password    binding.String
+	policy      *PasswordPolicy
+	listener    binding.DataListener
+	score       binding.Int
+	suggestions binding.StringList
+}
+
+// NewPasswordStrength returns a `PasswordStrength` rating the password held by the `password`
+// binding each time it changes. The score is based on the entropy of the password, and is at most 1
+// if the password does not follow the `policy`, that can be nil. The rules not followed are part
+// of the suggestions. You should call `Close()` once you are done to stop observing the password.
+func NewPasswordStrength(password binding.String, policy *PasswordPolicy) PasswordStrength {
+	ret := &passwordStrength{password: password, policy: policy,
+		score: binding.NewInt(), suggestions: binding.NewStringList()}
+	ret.update()
+	ret.listener = binding.NewDataListener(ret.update)
+	password.AddListener(ret.listener)
+
+	return ret
+}
+
+func (s *passwordStrength) Close() error {
+	s.password.RemoveListener(s.listener)
+	return nil
+}
+
+func (s *passwordStrength) Score() binding.Int {
+	return s.score
+}
+
+func (s *passwordStrength) Suggestions() binding.StringList {
+	return s.suggestions
+}
+
+func (s *passwordStrength) update() {
+	text, err := s.password.Get()
+	if err != nil {
+		return
+	}
+
+	score := 0
+	entropy := gpv.GetEntropy(text)
+	for score < len(entropyScores) && entropy >= entropyScores[score] {
+		score++
+	}
+
+	suggestions := []string{}
+	if s.policy != nil {
+		for _, err := range s.policy.check(text) {
+			suggestions = append(suggestions, err.Error())
+		}
+		if len(suggestions) > 0 && score > 1 {
+			score = 1
+		}
+	}
+	if len(suggestions) == 0 && score < 3 {
+		suggestions = append(suggestions, lang.Localize("use a longer password mixing letters, digits and symbols"))
+	}
+
+	// we control the bindings, Set will not error
+	_ = s.score.Set(score)
+	_ = s.suggestions.Set(suggestions)
+}
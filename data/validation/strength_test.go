@@ -0,0 +1,48 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/x/fyne/data/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForScore(t *testing.T, s validation.PasswordStrength, score int) {
+	for i := 0; i < 100; i++ {
+		if v, _ := s.Score().Get(); v == score {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	v, _ := s.Score().Get()
+	t.Fatalf("score %d, expected %d", v, score)
+}
+
+func TestPasswordStrength(t *testing.T) {
+	pw := binding.NewString()
+	s := validation.NewPasswordStrength(pw, nil)
+	defer s.Close()
+
+	waitForScore(t, s, 0)
+	suggestions, _ := s.Suggestions().Get()
+	assert.Len(t, suggestions, 1)
+
+	pw.Set("7-BreaD-Crumbs.^_SpeciaL")
+	waitForScore(t, s, 4)
+	suggestions, _ = s.Suggestions().Get()
+	assert.Empty(t, suggestions)
+}
+
+func TestPasswordStrength_Policy(t *testing.T) {
+	pw := binding.NewString()
+	s := validation.NewPasswordStrength(pw, validation.NewPasswordPolicy().RequireDigit().NoRepeats(2))
+	defer s.Close()
+
+	pw.Set("a long passphrase without numbers")
+	waitForScore(t, s, 1)
+	suggestions, _ := s.Suggestions().Get()
+	assert.Equal(t, []string{"add a digit"}, suggestions)
+}
@@ -0,0 +1,263 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+
+	"fyne.io/x/fyne/lang"
+)
+
+var (
+	uuidPattern   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	semverPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+	ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+)
+
+// newError returns an error with the `message` translated by lang.Localize, then formatted with `args`.
+func newError(message string, args ...interface{}) error {
+	if len(args) == 0 {
+		return errors.New(lang.Localize(message))
+	}
+	return fmt.Errorf(lang.Localize(message), args...)
+}
+
+// NewEmail returns a validator accepting an email address, without display name.
+func NewEmail() fyne.StringValidator {
+	return func(text string) error {
+		addr, err := mail.ParseAddress(text)
+		if err != nil || addr.Address != text || addr.Name != "" {
+			return newError("not a valid email address")
+		}
+		return nil
+	}
+}
+
+// NewURL returns a validator accepting an absolute URL with a host. If `schemes` are given,
+// the scheme of the URL has to be one of them.
+func NewURL(schemes ...string) fyne.StringValidator {
+	return func(text string) error {
+		u, err := url.Parse(text)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return newError("not a valid URL")
+		}
+		if len(schemes) == 0 {
+			return nil
+		}
+
+		for _, scheme := range schemes {
+			if strings.EqualFold(u.Scheme, scheme) {
+				return nil
+			}
+		}
+		return newError("URL scheme must be one of %s", strings.Join(schemes, ", "))
+	}
+}
+
+// NewIPv4 returns a validator accepting an IPv4 address in dotted decimal notation.
+func NewIPv4() fyne.StringValidator {
+	return func(text string) error {
+		if ip := net.ParseIP(text); ip == nil || ip.To4() == nil || strings.Contains(text, ":") {
+			return newError("not a valid IPv4 address")
+		}
+		return nil
+	}
+}
+
+// NewIPv6 returns a validator accepting an IPv6 address.
+func NewIPv6() fyne.StringValidator {
+	return func(text string) error {
+		if ip := net.ParseIP(text); ip == nil || !strings.Contains(text, ":") {
+			return newError("not a valid IPv6 address")
+		}
+		return nil
+	}
+}
+
+// NewCIDR returns a validator accepting an IPv4 or IPv6 network in CIDR notation, like "192.168.0.0/16".
+func NewCIDR() fyne.StringValidator {
+	return func(text string) error {
+		if _, _, err := net.ParseCIDR(text); err != nil {
+			return newError("not a valid CIDR network")
+		}
+		return nil
+	}
+}
+
+// NewHostname returns a validator accepting a host name as defined by RFC 1123.
+func NewHostname() fyne.StringValidator {
+	return func(text string) error {
+		name := strings.TrimSuffix(text, ".")
+		if name == "" || len(name) > 253 {
+			return newError("not a valid host name")
+		}
+
+		for _, label := range strings.Split(name, ".") {
+			if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+				return newError("not a valid host name")
+			}
+			for _, c := range label {
+				if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+					return newError("not a valid host name")
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// NewIntRange returns a validator accepting a whole number between `min` and `max` included.
+func NewIntRange(min, max int) fyne.StringValidator {
+	return func(text string) error {
+		i, err := strconv.Atoi(text)
+		if err != nil {
+			return newError("not a whole number")
+		}
+		if i < min || i > max {
+			return newError("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+// NewFloatRange returns a validator accepting a number between `min` and `max` included.
+func NewFloatRange(min, max float64) fyne.StringValidator {
+	return func(text string) error {
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return newError("not a number")
+		}
+		if f < min || f > max {
+			return newError("must be between %g and %g", min, max)
+		}
+		return nil
+	}
+}
+
+// NewLength returns a validator accepting a text of at least `min` and at most `max` characters.
+// If `max` is negative, the length is not limited.
+func NewLength(min, max int) fyne.StringValidator {
+	return func(text string) error {
+		count := utf8.RuneCountInString(text)
+		if count < min {
+			return newError("must be at least %d characters long", min)
+		}
+		if max >= 0 && count > max {
+			return newError("must be at most %d characters long", max)
+		}
+		return nil
+	}
+}
+
+// NewOneOf returns a validator accepting only one of the `values`.
+func NewOneOf(values ...string) fyne.StringValidator {
+	return func(text string) error {
+		for _, v := range values {
+			if text == v {
+				return nil
+			}
+		}
+		return newError("must be one of %s", strings.Join(values, ", "))
+	}
+}
+
+// NewISODate returns a validator accepting a date in the ISO 8601 format "YYYY-MM-DD".
+func NewISODate() fyne.StringValidator {
+	return func(text string) error {
+		if _, err := time.Parse("2006-01-02", text); err != nil {
+			return newError("not a valid date, expected YYYY-MM-DD")
+		}
+		return nil
+	}
+}
+
+// NewIBAN returns a validator accepting an International Bank Account Number, with its check digits
+// verified. Spaces are allowed between the groups of characters.
+func NewIBAN() fyne.StringValidator {
+	return func(text string) error {
+		iban := strings.ToUpper(strings.Replace(text, " ", "", -1))
+		if !ibanPattern.MatchString(iban) {
+			return newError("not a valid IBAN")
+		}
+
+		// move the country code and check digits at the end, and convert letters to numbers
+		var digits strings.Builder
+		for _, c := range iban[4:] + iban[:4] {
+			if c >= 'A' && c <= 'Z' {
+				digits.WriteString(strconv.Itoa(int(c-'A') + 10))
+			} else {
+				digits.WriteRune(c)
+			}
+		}
+
+		n, _ := new(big.Int).SetString(digits.String(), 10)
+		if n.Mod(n, big.NewInt(97)).Int64() != 1 {
+			return newError("IBAN check digits are not valid")
+		}
+		return nil
+	}
+}
+
+// NewLuhn returns a validator accepting a number passing the Luhn checksum, as used by credit cards.
+// Spaces and dashes are allowed between the groups of digits.
+func NewLuhn() fyne.StringValidator {
+	return func(text string) error {
+		sum, count := 0, 0
+		for i := len(text) - 1; i >= 0; i-- {
+			c := text[i]
+			if c == ' ' || c == '-' {
+				continue
+			}
+			if c < '0' || c > '9' {
+				return newError("must contain only digits")
+			}
+
+			d := int(c - '0')
+			if count%2 == 1 {
+				if d *= 2; d > 9 {
+					d -= 9
+				}
+			}
+			sum += d
+			count++
+		}
+
+		if count < 2 || sum%10 != 0 {
+			return newError("not a valid card number")
+		}
+		return nil
+	}
+}
+
+// NewUUID returns a validator accepting a UUID in its canonical form, like "123e4567-e89b-12d3-a456-426614174000".
+func NewUUID() fyne.StringValidator {
+	return func(text string) error {
+		if !uuidPattern.MatchString(text) {
+			return newError("not a valid UUID")
+		}
+		return nil
+	}
+}
+
+// NewSemver returns a validator accepting a semantic version, like "1.2.3-beta.1+build.5".
+// See https://semver.org for the specification.
+func NewSemver() fyne.StringValidator {
+	return func(text string) error {
+		if !semverPattern.MatchString(text) {
+			return newError("not a valid semantic version")
+		}
+		return nil
+	}
+}
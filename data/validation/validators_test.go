@@ -0,0 +1,134 @@
+package validation_test
+
+import (
+	"testing"
+
+	"fyne.io/x/fyne/data/validation"
+	"fyne.io/x/fyne/lang"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmail(t *testing.T) {
+	v := validation.NewEmail()
+
+	assert.NoError(t, v("user@example.com"))
+	assert.Error(t, v("user"))
+	assert.Error(t, v("User <user@example.com>"))
+	assert.Error(t, v(" user@example.com"))
+}
+
+func TestURL(t *testing.T) {
+	v := validation.NewURL()
+	assert.NoError(t, v("ftp://example.com/file"))
+	assert.Error(t, v("example.com"))
+	assert.Error(t, v("/relative/path"))
+
+	v = validation.NewURL("http", "https")
+	assert.NoError(t, v("HTTPS://example.com"))
+	assert.Error(t, v("ftp://example.com/file"))
+}
+
+func TestIP(t *testing.T) {
+	v4, v6, cidr := validation.NewIPv4(), validation.NewIPv6(), validation.NewCIDR()
+
+	assert.NoError(t, v4("192.168.1.1"))
+	assert.Error(t, v4("256.1.1.1"))
+	assert.Error(t, v4("::ffff:192.168.1.1"))
+
+	assert.NoError(t, v6("2001:db8::1"))
+	assert.Error(t, v6("192.168.1.1"))
+
+	assert.NoError(t, cidr("10.0.0.0/8"))
+	assert.NoError(t, cidr("2001:db8::/32"))
+	assert.Error(t, cidr("10.0.0.0"))
+}
+
+func TestHostname(t *testing.T) {
+	v := validation.NewHostname()
+
+	assert.NoError(t, v("example.com"))
+	assert.NoError(t, v("my-host."))
+	assert.Error(t, v("-bad.com"))
+	assert.Error(t, v("bad..com"))
+	assert.Error(t, v("under_score.com"))
+}
+
+func TestRanges(t *testing.T) {
+	i := validation.NewIntRange(1, 10)
+	assert.NoError(t, i("10"))
+	assert.Error(t, i("11"))
+	assert.Error(t, i("1.5"))
+
+	f := validation.NewFloatRange(-1, 1)
+	assert.NoError(t, f("0.5"))
+	assert.Error(t, f("1.5"))
+	assert.Error(t, f("one"))
+
+	l := validation.NewLength(2, 3)
+	assert.NoError(t, l("été"))
+	assert.Error(t, l("a"))
+	assert.Error(t, l("abcd"))
+	assert.NoError(t, validation.NewLength(1, -1)("a long text"))
+}
+
+func TestOneOf(t *testing.T) {
+	v := validation.NewOneOf("red", "green")
+
+	assert.NoError(t, v("red"))
+	assert.EqualError(t, v("blue"), "must be one of red, green")
+}
+
+func TestISODate(t *testing.T) {
+	v := validation.NewISODate()
+
+	assert.NoError(t, v("2024-02-29"))
+	assert.Error(t, v("2023-02-29"))
+	assert.Error(t, v("29/02/2024"))
+}
+
+func TestIBAN(t *testing.T) {
+	v := validation.NewIBAN()
+
+	assert.NoError(t, v("GB82 WEST 1234 5698 7654 32"))
+	assert.NoError(t, v("DE89370400440532013000"))
+	assert.Error(t, v("GB82 WEST 1234 5698 7654 33"))
+	assert.Error(t, v("not an iban"))
+}
+
+func TestLuhn(t *testing.T) {
+	v := validation.NewLuhn()
+
+	assert.NoError(t, v("4111 1111 1111 1111"))
+	assert.NoError(t, v("79927398713"))
+	assert.Error(t, v("79927398710"))
+	assert.Error(t, v("4111-1111-1111-111a"))
+}
+
+func TestUUID(t *testing.T) {
+	v := validation.NewUUID()
+
+	assert.NoError(t, v("123e4567-e89b-12d3-a456-426614174000"))
+	assert.Error(t, v("123e4567e89b12d3a456426614174000"))
+}
+
+func TestSemver(t *testing.T) {
+	v := validation.NewSemver()
+
+	assert.NoError(t, v("1.2.3"))
+	assert.NoError(t, v("1.0.0-alpha.1+build.5"))
+	assert.Error(t, v("1.2"))
+	assert.Error(t, v("01.2.3"))
+}
+
+func TestLocalize(t *testing.T) {
+	defer func(l func(string) string) { lang.Localize = l }(lang.Localize)
+	lang.Localize = func(message string) string {
+		if message == "must be between %d and %d" {
+			return "doit être entre %d et %d"
+		}
+		return message
+	}
+
+	assert.EqualError(t, validation.NewIntRange(1, 2)("3"), "doit être entre 1 et 2")
+}
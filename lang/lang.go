@@ -0,0 +1,11 @@
+// Package lang holds the translation hook shared by the widgets and the validators of this module.
+//
+// Fyne v2.4.1, that this module depends on, has no `lang` package to translate texts, so they are
+// translated by the function set in Localize, such as `lang.L` of later versions of Fyne.
+package lang // import "fyne.io/x/fyne/lang"
+
+// Localize translates the texts of this module, that are in English. The texts can contain `fmt`
+// verbs, that the translation must keep in the same order.
+var Localize = func(text string) string {
+	return text
+}
@@ -2,6 +2,7 @@ package widget
 
 import (
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
 )
 
 // Declare conformity with Layout interface
@@ -21,12 +24,27 @@ const (
 	maxWeeksPerMonth = 6
 )
 
+// CalendarSelectionMode is the way days are selected in a Calendar.
+type CalendarSelectionMode int
+
+const (
+	// CalendarSingle selects one day at a time.
+	CalendarSingle CalendarSelectionMode = iota
+	// CalendarRange selects the days between two tapped days.
+	CalendarRange
+	// CalendarMultiple toggles the selection of each tapped day.
+	CalendarMultiple
+)
+
 type calendarLayout struct {
+	cols     int
 	cellSize fyne.Size
 }
 
-func newCalendarLayout() fyne.Layout {
-	return &calendarLayout{}
+// newCalendarLayout returns a layout for a calendar with `cols` columns, the days of the week and
+// optionally the week numbers.
+func newCalendarLayout(cols int) fyne.Layout {
+	return &calendarLayout{cols: cols}
 }
 
 // Get the leading edge position of a grid cell.
@@ -55,13 +73,13 @@ func (g *calendarLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 			continue
 		}
 
-		if day%daysPerWeek == 0 && i >= daysPerWeek {
+		if day%g.cols == 0 && i >= g.cols {
 			weeks++
 		}
 		day++
 	}
 
-	g.cellSize = fyne.NewSize(size.Width/float32(daysPerWeek),
+	g.cellSize = fyne.NewSize(size.Width/float32(g.cols),
 		size.Height/float32(weeks))
 	row, col := 0, 0
 	i := 0
@@ -75,7 +93,7 @@ func (g *calendarLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 		child.Move(lead)
 		child.Resize(fyne.NewSize(trail.X, trail.Y).Subtract(lead))
 
-		if (i+1)%daysPerWeek == 0 {
+		if (i+1)%g.cols == 0 {
 			row++
 			col = 0
 		} else {
@@ -89,7 +107,7 @@ func (g *calendarLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
 func (g *calendarLayout) MinSize(_ []fyne.CanvasObject) fyne.Size {
 	pad := theme.Padding()
 	largestMin := widget.NewLabel("22").MinSize()
-	return fyne.NewSize(largestMin.Width*daysPerWeek+pad*(daysPerWeek-1),
+	return fyne.NewSize(largestMin.Width*float32(g.cols)+pad*float32(g.cols-1),
 		largestMin.Height*maxWeeksPerMonth+pad*(maxWeeksPerMonth-1))
 }
 
@@ -98,41 +116,93 @@ type Calendar struct {
 	widget.BaseWidget
 	currentTime time.Time
 
+	// SelectionMode is the way days are selected, a single day by default.
+	SelectionMode CalendarSelectionMode
+	// OnRangeSelected is called in range mode once both ends of the range are selected.
+	OnRangeSelected func(start, end time.Time)
+	// OnMultipleSelected is called in multiple mode each time a day is toggled, with the selected days in order.
+	OnMultipleSelected func([]time.Time)
+
+	// MinDate and MaxDate are the first and last days that can be selected, if not zero.
+	// Navigation stops at the pages showing them.
+	MinDate, MaxDate time.Time
+	// IsDateDisabled is called for each day shown, and returns true if the day can not be selected.
+	IsDateDisabled func(time.Time) bool
+	// FirstWeekday is the day shown in the first column, set by NewCalendar from the locale.
+	FirstWeekday time.Weekday
+	// ShowWeekNumbers adds a column with the ISO 8601 week numbers.
+	ShowWeekNumbers bool
+
+	// DecorateDay is called for each day shown, and returns the importance of its button when not
+	// selected, and a badge shown next to the day number, like an event dot or count, or "" for none.
+	DecorateDay func(time.Time) (importance widget.Importance, badge string)
+
+	selected  []time.Time
+	view      calendarView
+	focused   bool
+	focusDate time.Time
+
 	monthPrevious *widget.Button
 	monthNext     *widget.Button
-	monthLabel    *widget.Label
+	monthLabel    *widget.Button
 
 	dates *fyne.Container
 
 	onSelected func(time.Time)
 }
 
+// columns returns the number of columns of the calendar, with the week numbers if shown.
+func (c *Calendar) columns() int {
+	if c.ShowWeekNumbers {
+		return daysPerWeek + 1
+	}
+	return daysPerWeek
+}
+
 func (c *Calendar) daysOfMonth() []fyne.CanvasObject {
 	start := time.Date(c.currentTime.Year(), c.currentTime.Month(), 1, 0, 0, 0, 0, c.currentTime.Location())
 	buttons := []fyne.CanvasObject{}
 
-	//account for Go time pkg starting on sunday at index 0
-	dayIndex := int(start.Weekday())
-	if dayIndex == 0 {
-		dayIndex += daysPerWeek
+	//add spacers if week doesn't start on the first weekday
+	offset := (int(start.Weekday()) - int(c.FirstWeekday) + daysPerWeek) % daysPerWeek
+	if c.ShowWeekNumbers {
+		buttons = append(buttons, weekNumber(start.AddDate(0, 0, -offset)))
 	}
-
-	//add spacers if week doesn't start on Monday
-	for i := 0; i < dayIndex-1; i++ {
+	for i := 0; i < offset; i++ {
 		buttons = append(buttons, layout.NewSpacer())
 	}
 
 	for d := start; d.Month() == start.Month(); d = d.AddDate(0, 0, 1) {
+		if c.ShowWeekNumbers && d.Day() > 1 && d.Weekday() == c.FirstWeekday {
+			buttons = append(buttons, weekNumber(d))
+		}
 
 		dayNum := d.Day()
 		s := strconv.Itoa(dayNum)
+		importance, badge := widget.LowImportance, ""
+		if c.DecorateDay != nil {
+			importance, badge = c.DecorateDay(d)
+		}
+		if badge != "" {
+			s += " " + badge
+		}
+
 		b := widget.NewButton(s, func() {
 
 			selectedDate := c.dateForButton(dayNum)
 
-			c.onSelected(selectedDate)
+			c.selectDate(selectedDate)
 		})
-		b.Importance = widget.LowImportance
+		b.Importance = c.importance(d)
+		if b.Importance == widget.LowImportance {
+			b.Importance = importance
+		}
+		if c.focused && sameDay(d, c.focusDate) && !c.isSelected(d) {
+			b.Importance = widget.MediumImportance
+		}
+		if c.isDisabled(d) {
+			b.Disable()
+		}
 
 		buttons = append(buttons, b)
 	}
@@ -142,55 +212,167 @@ func (c *Calendar) daysOfMonth() []fyne.CanvasObject {
 
 func (c *Calendar) dateForButton(dayNum int) time.Time {
 	oldName, off := c.currentTime.Zone()
-	return time.Date(c.currentTime.Year(), c.currentTime.Month(), dayNum, c.currentTime.Hour(), c.currentTime.Minute(), 0, 0, time.FixedZone(oldName, off)).In(c.currentTime.Location())
+	return time.Date(c.currentTime.Year(), c.currentTime.Month(), dayNum, c.currentTime.Hour(), c.currentTime.Minute(), c.currentTime.Second(), 0, time.FixedZone(oldName, off)).In(c.currentTime.Location())
+}
+
+// importance returns the importance of the button of the day `d`, highlighting the selection.
+func (c *Calendar) importance(d time.Time) widget.Importance {
+	switch {
+	case c.SelectionMode == CalendarRange && len(c.selected) == 2:
+		if sameDay(d, c.selected[0]) || sameDay(d, c.selected[1]) {
+			return widget.HighImportance
+		}
+		if d.After(c.selected[0]) && d.Before(c.selected[1]) {
+			return widget.MediumImportance
+		}
+	default:
+		for _, s := range c.selected {
+			if sameDay(d, s) {
+				return widget.HighImportance
+			}
+		}
+	}
+	return widget.LowImportance
 }
 
 func (c *Calendar) monthYear() string {
-	return c.currentTime.Format("January 2006")
+	return lang.Localize(c.currentTime.Month().String()) + " " + strconv.Itoa(c.currentTime.Year())
 }
 
 func (c *Calendar) calendarObjects() []fyne.CanvasObject {
 	columnHeadings := []fyne.CanvasObject{}
-	for i := 0; i < daysPerWeek; i++ {
-		j := i + 1
-		if j == daysPerWeek {
-			j = 0
-		}
-
-		t := widget.NewLabel(strings.ToUpper(time.Weekday(j).String()[:3]))
+	if c.ShowWeekNumbers {
+		t := widget.NewLabel(strings.ToUpper(lang.Localize("Wk")))
 		t.Alignment = fyne.TextAlignCenter
+		t.Importance = widget.LowImportance
 		columnHeadings = append(columnHeadings, t)
 	}
+	columnHeadings = append(columnHeadings, weekdayHeadings(c.FirstWeekday)...)
 	columnHeadings = append(columnHeadings, c.daysOfMonth()...)
 
 	return columnHeadings
 }
 
+// Selection returns the selected days: one day in single mode, the start and end days in range
+// mode, or only the start day while the end is not selected yet, and the days in order in multiple mode.
+func (c *Calendar) Selection() []time.Time {
+	return append([]time.Time{}, c.selected...)
+}
+
+// SetSelection selects the `dates` according to the selection mode, without calling the callbacks:
+// the first date in single mode, the first two dates in range mode and all of them in multiple mode.
+func (c *Calendar) SetSelection(dates ...time.Time) {
+	switch c.SelectionMode {
+	case CalendarSingle:
+		if len(dates) > 1 {
+			dates = dates[:1]
+		}
+	case CalendarRange:
+		if len(dates) > 2 {
+			dates = dates[:2]
+		}
+	}
+
+	c.selected = nil
+	for _, d := range dates {
+		if !c.isSelected(d) {
+			c.selected = append(c.selected, d)
+		}
+	}
+	sort.Slice(c.selected, func(i, j int) bool { return c.selected[i].Before(c.selected[j]) })
+	c.refreshDates()
+}
+
+// isDisabled reports if the day `d` can not be selected.
+func (c *Calendar) isDisabled(d time.Time) bool {
+	return outOfDays(d, c.MinDate, c.MaxDate) || c.IsDateDisabled != nil && c.IsDateDisabled(d)
+}
+
+func (c *Calendar) isSelected(d time.Time) bool {
+	for _, s := range c.selected {
+		if sameDay(d, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Calendar) refreshDates() {
+	if c.dates == nil {
+		return
+	}
+
+	if c.view == calendarDays {
+		c.dates.Layout = newCalendarLayout(c.columns())
+		c.dates.Objects = c.calendarObjects()
+	} else {
+		c.dates.Layout = layout.NewGridLayoutWithColumns(calendarGridColumns)
+		c.dates.Objects = c.gridObjects()
+	}
+	c.dates.Refresh()
+	c.refreshNavigation()
+}
+
+// selectDate updates the selection when the day `d` is tapped, and calls the callbacks.
+func (c *Calendar) selectDate(d time.Time) {
+	tapped := d
+	switch c.SelectionMode {
+	case CalendarRange:
+		if len(c.selected) != 1 {
+			c.selected = []time.Time{d}
+			break
+		}
+
+		start := c.selected[0]
+		if d.Before(start) {
+			start, d = d, start
+		}
+		c.selected = []time.Time{start, d}
+		if c.OnRangeSelected != nil {
+			defer c.OnRangeSelected(start, d)
+		}
+	case CalendarMultiple:
+		selected := c.selected[:0]
+		for _, s := range c.selected {
+			if !sameDay(d, s) {
+				selected = append(selected, s)
+			}
+		}
+		if len(selected) == len(c.selected) {
+			selected = append(selected, d)
+			sort.Slice(selected, func(i, j int) bool { return selected[i].Before(selected[j]) })
+		}
+		c.selected = selected
+		if c.OnMultipleSelected != nil {
+			defer c.OnMultipleSelected(c.Selection())
+		}
+	default:
+		c.selected = []time.Time{d}
+	}
+
+	c.refreshDates()
+	if c.onSelected != nil {
+		c.onSelected(tapped)
+	}
+}
+
 // CreateRenderer returns a new WidgetRenderer for this widget.
 // This should not be called by regular code, it is used internally to render a widget.
 func (c *Calendar) CreateRenderer() fyne.WidgetRenderer {
 	c.monthPrevious = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
-		c.currentTime = c.currentTime.AddDate(0, -1, 0)
-		// Dates are 'normalised', forcing date to start from the start of the month ensures move from March to February
-		c.currentTime = time.Date(c.currentTime.Year(), c.currentTime.Month(), 1, 0, 0, 0, 0, c.currentTime.Location())
-		c.monthLabel.SetText(c.monthYear())
-		c.dates.Objects = c.calendarObjects()
+		c.navigate(-1)
 	})
-	c.monthPrevious.Importance = widget.LowImportance
-
 	c.monthNext = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
-		c.currentTime = c.currentTime.AddDate(0, 1, 0)
-		c.monthLabel.SetText(c.monthYear())
-		c.dates.Objects = c.calendarObjects()
+		c.navigate(1)
 	})
-	c.monthNext.Importance = widget.LowImportance
 
-	c.monthLabel = widget.NewLabel(c.monthYear())
+	c.monthLabel = widget.NewButton(c.monthYear(), c.zoomOut)
+	c.monthLabel.Importance = widget.LowImportance
 
-	nav := container.New(layout.NewBorderLayout(nil, nil, c.monthPrevious, c.monthNext),
-		c.monthPrevious, c.monthNext, container.NewCenter(c.monthLabel))
+	nav := newNavigationBar(c.monthPrevious, c.monthNext, c.monthLabel)
 
-	c.dates = container.New(newCalendarLayout(), c.calendarObjects()...)
+	c.dates = container.New(newCalendarLayout(c.columns()), c.calendarObjects()...)
+	c.refreshNavigation()
 
 	dateContainer := container.NewBorder(nav, nil, nil, nil, c.dates)
 
@@ -200,11 +382,48 @@ func (c *Calendar) CreateRenderer() fyne.WidgetRenderer {
 // NewCalendar creates a calendar instance
 func NewCalendar(cT time.Time, onSelected func(time.Time)) *Calendar {
 	c := &Calendar{
-		currentTime: cT,
-		onSelected:  onSelected,
+		currentTime:  cT,
+		onSelected:   onSelected,
+		FirstWeekday: localeFirstWeekday(),
 	}
 
 	c.ExtendBaseWidget(c)
 
 	return c
 }
+
+// outOfDays reports if the day `d` is before the day `min` or after the day `max`, when they are not zero.
+func outOfDays(d, min, max time.Time) bool {
+	return !min.IsZero() && d.Before(min) && !sameDay(d, min) || !max.IsZero() && d.After(max) && !sameDay(d, max)
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// weekdayHeadings returns the labels of the days of the week, starting with `first`.
+func weekdayHeadings(first time.Weekday) []fyne.CanvasObject {
+	headings := make([]fyne.CanvasObject, daysPerWeek)
+	for i := range headings {
+		j := (int(first) + i) % daysPerWeek
+
+		t := widget.NewLabel(strings.ToUpper(shortName(time.Weekday(j).String())))
+		t.Alignment = fyne.TextAlignCenter
+		headings[i] = t
+	}
+	return headings
+}
+
+// weekNumber returns a label with the ISO 8601 number of the week of the row starting with `d`,
+// which is the week of its Monday.
+func weekNumber(d time.Time) fyne.CanvasObject {
+	monday := d.AddDate(0, 0, (int(time.Monday)-int(d.Weekday())+daysPerWeek)%daysPerWeek)
+	_, week := monday.ISOWeek()
+
+	l := widget.NewLabel(strconv.Itoa(week))
+	l.Alignment = fyne.TextAlignCenter
+	l.Importance = widget.LowImportance
+	return l
+}
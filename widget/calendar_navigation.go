@@ -0,0 +1,303 @@
+package widget
+
+import (
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with the interfaces of focusable widgets
+var _ fyne.Focusable = (*Calendar)(nil)
+var _ fyne.Tappable = (*Calendar)(nil)
+
+// calendarGridColumns is the number of columns of the months, years and decades views.
+const calendarGridColumns = 4
+
+// calendarView is a view of a Calendar, showing the days of a month, the months of a year,
+// the years of a decade or the decades of a century.
+type calendarView int
+
+const (
+	calendarDays calendarView = iota
+	calendarMonths
+	calendarYears
+	calendarDecades
+)
+
+// FocusGained is called when the calendar has been given focus, the focused day can then be moved
+// with the keyboard.
+//
+// Implements: fyne.Focusable
+func (c *Calendar) FocusGained() {
+	c.focused = true
+	if c.focusDate.IsZero() || !c.pageStart(c.focusDate).Equal(c.pageStart(c.currentTime)) {
+		c.focusDate = c.currentTime
+	}
+	c.refreshDates()
+}
+
+// FocusLost is called when the calendar has had focus removed.
+//
+// Implements: fyne.Focusable
+func (c *Calendar) FocusLost() {
+	c.focused = false
+	c.refreshDates()
+}
+
+// Tapped is called when the calendar is tapped outside of its buttons, and focuses it.
+//
+// Implements: fyne.Tappable
+func (c *Calendar) Tapped(*fyne.PointEvent) {
+	if canvas := fyne.CurrentApp().Driver().CanvasForObject(c); canvas != nil {
+		canvas.Focus(c)
+	}
+}
+
+// TypedKey is called if a non-printable key is pressed while the calendar is focused. The arrow keys,
+// PageUp, PageDown, Home and End move the focused day, and Enter selects it.
+//
+// Implements: fyne.Focusable
+func (c *Calendar) TypedKey(ev *fyne.KeyEvent) {
+	columns := calendarGridColumns
+	if c.view == calendarDays {
+		columns = daysPerWeek
+	}
+
+	switch ev.Name {
+	case fyne.KeyLeft:
+		c.moveFocus(c.step(-1))
+	case fyne.KeyRight:
+		c.moveFocus(c.step(1))
+	case fyne.KeyUp:
+		c.moveFocus(c.step(-columns))
+	case fyne.KeyDown:
+		c.moveFocus(c.step(columns))
+	case fyne.KeyPageUp:
+		c.moveFocus(c.page(c.focusDate, -1))
+	case fyne.KeyPageDown:
+		c.moveFocus(c.page(c.focusDate, 1))
+	case fyne.KeyHome, fyne.KeyEnd:
+		c.moveFocus(c.lineEnd(ev.Name == fyne.KeyEnd))
+	case fyne.KeyReturn, fyne.KeyEnter, fyne.KeySpace:
+		c.activate(c.focusDate)
+	}
+}
+
+// TypedRune is called if a text event happens while the calendar is focused, it is ignored.
+//
+// Implements: fyne.Focusable
+func (c *Calendar) TypedRune(rune) {
+}
+
+// activate selects the day `t` in the days view, or shows the period `t` in the other views.
+func (c *Calendar) activate(t time.Time) {
+	if c.view == calendarDays {
+		if !c.isDisabled(t) {
+			c.selectDate(t)
+		}
+		return
+	}
+
+	c.view--
+	c.currentTime = c.pageStart(t)
+	c.focusDate = c.currentTime
+	c.refreshDates()
+}
+
+// gridObjects returns the buttons of the months, years or decades of the current page.
+func (c *Calendar) gridObjects() []fyne.CanvasObject {
+	start := c.pageStart(c.currentTime)
+	count := 10
+	if c.view == calendarMonths {
+		count = 12
+	}
+
+	buttons := []fyne.CanvasObject{}
+	for i := 0; i < count; i++ {
+		t := c.unitStart(i, start)
+		label := strconv.Itoa(t.Year())
+		switch c.view {
+		case calendarMonths:
+			label = shortName(t.Month().String())
+		case calendarDecades:
+			label += "s"
+		}
+
+		b := widget.NewButton(label, func() {
+			c.activate(t)
+		})
+		b.Importance = widget.LowImportance
+		end := c.unitStart(1, t)
+		switch {
+		case c.focused && !c.focusDate.Before(t) && c.focusDate.Before(end):
+			b.Importance = widget.MediumImportance
+		case !c.MaxDate.IsZero() && t.After(c.MaxDate), !c.MinDate.IsZero() && !end.After(c.MinDate):
+			b.Disable()
+		}
+		buttons = append(buttons, b)
+	}
+	return buttons
+}
+
+// lineEnd returns the first or last date of the week, in the days view, or of the page.
+func (c *Calendar) lineEnd(last bool) time.Time {
+	if c.view == calendarDays {
+		offset := (int(c.focusDate.Weekday()) - int(c.FirstWeekday) + daysPerWeek) % daysPerWeek
+		if last {
+			return c.focusDate.AddDate(0, 0, daysPerWeek-1-offset)
+		}
+		return c.focusDate.AddDate(0, 0, -offset)
+	}
+
+	start := c.pageStart(c.focusDate)
+	if last {
+		return c.unitStart(-1, c.page(start, 1))
+	}
+	return start
+}
+
+// moveFocus moves the focused date to `t`, within the bounds, and shows its page.
+func (c *Calendar) moveFocus(t time.Time) {
+	if !c.MinDate.IsZero() && t.Before(c.MinDate) {
+		t = c.MinDate
+	}
+	if !c.MaxDate.IsZero() && t.After(c.MaxDate) {
+		t = c.MaxDate
+	}
+
+	c.focusDate = time.Date(t.Year(), t.Month(), t.Day(), c.currentTime.Hour(), c.currentTime.Minute(), 0, 0,
+		c.currentTime.Location())
+	c.currentTime = c.pageStart(c.focusDate)
+	c.refreshDates()
+}
+
+// navigate shows the next page of the current view if `direction` is positive, or the previous one.
+func (c *Calendar) navigate(direction int) {
+	c.currentTime = c.page(c.currentTime, direction)
+	c.refreshDates()
+}
+
+// page returns the date `t` moved by `count` pages of the current view, keeping the day within the month.
+func (c *Calendar) page(t time.Time, count int) time.Time {
+	years, months := 0, 0
+	switch c.view {
+	case calendarDays:
+		months = count
+	case calendarMonths:
+		years = count
+	case calendarYears:
+		years = 10 * count
+	case calendarDecades:
+		years = 100 * count
+	}
+
+	return addMonths(t, 12*years+months)
+}
+
+// pageStart returns the first day of the page of the current view showing `t`.
+func (c *Calendar) pageStart(t time.Time) time.Time {
+	year, month := t.Year(), t.Month()
+	switch c.view {
+	case calendarMonths:
+		month = time.January
+	case calendarYears:
+		year, month = year-floorMod(year, 10), time.January
+	case calendarDecades:
+		year, month = year-floorMod(year, 100), time.January
+	}
+	return time.Date(year, month, 1, c.currentTime.Hour(), c.currentTime.Minute(), 0, 0, c.currentTime.Location())
+}
+
+// refreshNavigation disables the navigation to the pages before MinDate or after MaxDate.
+func (c *Calendar) refreshNavigation() {
+	start := c.pageStart(c.currentTime)
+	start = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	if !c.MinDate.IsZero() && !start.After(c.MinDate) {
+		c.monthPrevious.Disable()
+	} else {
+		c.monthPrevious.Enable()
+	}
+	if !c.MaxDate.IsZero() && c.page(start, 1).After(c.MaxDate) {
+		c.monthNext.Disable()
+	} else {
+		c.monthNext.Enable()
+	}
+
+	c.monthLabel.SetText(c.title())
+	if c.view == calendarDecades {
+		c.monthLabel.Disable()
+	} else {
+		c.monthLabel.Enable()
+	}
+}
+
+// step returns the focused date moved by `count` days, months, years or decades, depending on the view.
+func (c *Calendar) step(count int) time.Time {
+	return c.unitStart(count, c.focusDate)
+}
+
+// title returns the title of the current page.
+func (c *Calendar) title() string {
+	year := c.pageStart(c.currentTime).Year()
+	switch c.view {
+	case calendarMonths:
+		return strconv.Itoa(year)
+	case calendarYears:
+		return strconv.Itoa(year) + " - " + strconv.Itoa(year+9)
+	case calendarDecades:
+		return strconv.Itoa(year) + " - " + strconv.Itoa(year+99)
+	}
+	return c.monthYear()
+}
+
+// unitStart returns the date `t` moved by `count` days, months, years or decades, depending on the
+// view, at the start of the month, year or decade outside of the days view.
+func (c *Calendar) unitStart(count int, t time.Time) time.Time {
+	switch c.view {
+	case calendarMonths:
+		return time.Date(t.Year(), t.Month()+time.Month(count), 1, t.Hour(), t.Minute(), 0, 0, t.Location())
+	case calendarYears:
+		return time.Date(t.Year()+count, time.January, 1, t.Hour(), t.Minute(), 0, 0, t.Location())
+	case calendarDecades:
+		year := t.Year() - floorMod(t.Year(), 10) + 10*count
+		return time.Date(year, time.January, 1, t.Hour(), t.Minute(), 0, 0, t.Location())
+	}
+	return t.AddDate(0, 0, count)
+}
+
+// zoomOut shows the months of the year, the years of the decade or the decades of the century.
+func (c *Calendar) zoomOut() {
+	if c.view == calendarDecades {
+		return
+	}
+
+	c.view++
+	c.focusDate = c.unitStart(0, c.currentTime)
+	c.currentTime = c.pageStart(c.currentTime)
+	c.refreshDates()
+}
+
+// addMonths returns the date `t` moved by `months`, keeping the day within the month.
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	day := t.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// newNavigationBar returns a bar with the `previous` and `next` buttons around the `title`, as shown
+// by the Calendar and the Scheduler.
+func newNavigationBar(previous, next *widget.Button, title fyne.CanvasObject) *fyne.Container {
+	previous.Importance, next.Importance = widget.LowImportance, widget.LowImportance
+	return container.New(layout.NewBorderLayout(nil, nil, previous, next), previous, next, container.NewCenter(title))
+}
+
+func floorMod(a, b int) int {
+	return ((a % b) + b) % b
+}
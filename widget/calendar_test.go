@@ -2,14 +2,18 @@ package widget
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
 )
 
 func TestNewCalendar(t *testing.T) {
@@ -93,3 +97,288 @@ func firstDateButton(c *fyne.Container) *widget.Button {
 
 	return nil
 }
+
+func TestCalendar_SelectSingle(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	var selected time.Time
+	c := NewCalendar(date, func(d time.Time) { selected = d })
+	_ = test.WidgetRenderer(c)
+
+	test.Tap(dayButton(c, 5))
+	assert.Equal(t, 5, selected.Day())
+	assert.Equal(t, widget.HighImportance, dayButton(c, 5).Importance)
+	test.Tap(dayButton(c, 7))
+	assert.Equal(t, widget.LowImportance, dayButton(c, 5).Importance)
+	assert.Equal(t, []time.Time{selected}, c.Selection())
+}
+
+func TestCalendar_SelectRange(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	var start, end time.Time
+	c := NewCalendar(date, nil)
+	c.SelectionMode = CalendarRange
+	c.OnRangeSelected = func(s, e time.Time) { start, end = s, e }
+	_ = test.WidgetRenderer(c)
+
+	test.Tap(dayButton(c, 20))
+	assert.True(t, end.IsZero())
+	assert.Len(t, c.Selection(), 1)
+	test.Tap(dayButton(c, 12))
+	assert.Equal(t, 12, start.Day())
+	assert.Equal(t, 20, end.Day())
+
+	assert.Equal(t, widget.HighImportance, dayButton(c, 12).Importance)
+	assert.Equal(t, widget.MediumImportance, dayButton(c, 15).Importance)
+	assert.Equal(t, widget.HighImportance, dayButton(c, 20).Importance)
+	assert.Equal(t, widget.LowImportance, dayButton(c, 21).Importance)
+
+	test.Tap(dayButton(c, 25)) // starts a new range
+	assert.Len(t, c.Selection(), 1)
+	assert.Equal(t, widget.LowImportance, dayButton(c, 15).Importance)
+}
+
+func TestCalendar_SelectMultiple(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	var days []time.Time
+	c := NewCalendar(date, nil)
+	c.SelectionMode = CalendarMultiple
+	c.OnMultipleSelected = func(d []time.Time) { days = d }
+	_ = test.WidgetRenderer(c)
+
+	test.Tap(dayButton(c, 9))
+	test.Tap(dayButton(c, 3))
+	test.Tap(dayButton(c, 6))
+	assert.Len(t, days, 3)
+	assert.Equal(t, 3, days[0].Day())
+	assert.Equal(t, 9, days[2].Day())
+
+	test.Tap(dayButton(c, 3))
+	assert.Len(t, days, 2)
+	assert.Equal(t, widget.LowImportance, dayButton(c, 3).Importance)
+	assert.Equal(t, widget.HighImportance, dayButton(c, 6).Importance)
+}
+
+func TestCalendar_SetSelection(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.SelectionMode = CalendarRange
+	_ = test.WidgetRenderer(c)
+
+	c.SetSelection(date.AddDate(0, 0, 4), date, date.AddDate(0, 0, 10))
+	selection := c.Selection()
+	assert.Len(t, selection, 2)
+	assert.Equal(t, 10, selection[0].Day())
+	assert.Equal(t, 14, selection[1].Day())
+	assert.Equal(t, widget.MediumImportance, dayButton(c, 12).Importance)
+
+	c.SelectionMode = CalendarMultiple
+	c.SetSelection(date, date, date.AddDate(0, 0, 1))
+	assert.Len(t, c.Selection(), 2)
+}
+
+func dayButton(c *Calendar, day int) *widget.Button {
+	for _, o := range c.dates.Objects {
+		if b, ok := o.(*widget.Button); ok && strings.SplitN(b.Text, " ", 2)[0] == strconv.Itoa(day) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+func TestCalendar_DisabledDays(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.MinDate = time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	c.MaxDate = time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC)
+	c.IsDateDisabled = func(d time.Time) bool {
+		return d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+	}
+	_ = test.WidgetRenderer(c)
+
+	assert.True(t, dayButton(c, 4).Disabled())
+	assert.False(t, dayButton(c, 5).Disabled())
+	assert.True(t, dayButton(c, 9).Disabled()) // Saturday
+	assert.False(t, dayButton(c, 11).Disabled())
+	assert.True(t, c.monthPrevious.Disabled())
+	assert.False(t, c.monthNext.Disabled())
+
+	test.Tap(c.monthNext)
+	assert.False(t, dayButton(c, 19).Disabled())
+	assert.True(t, dayButton(c, 22).Disabled())
+	assert.False(t, c.monthPrevious.Disabled())
+	assert.True(t, c.monthNext.Disabled())
+}
+
+func TestCalendar_DecorateDay(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.DecorateDay = func(d time.Time) (widget.Importance, string) {
+		if d.Day() == 14 {
+			return widget.WarningImportance, "3"
+		}
+		return widget.LowImportance, ""
+	}
+	_ = test.WidgetRenderer(c)
+
+	assert.Equal(t, "14 3", dayButton(c, 14).Text)
+	assert.Equal(t, widget.WarningImportance, dayButton(c, 14).Importance)
+	assert.Equal(t, "15", dayButton(c, 15).Text)
+
+	test.Tap(dayButton(c, 14))
+	assert.Equal(t, widget.HighImportance, dayButton(c, 14).Importance)
+}
+
+func TestCalendar_FirstWeekday(t *testing.T) {
+	date := time.Date(2024, time.September, 10, 12, 0, 0, 0, time.UTC) // the 1st is a Sunday
+	c := NewCalendar(date, nil)
+	c.FirstWeekday = time.Monday
+	_ = test.WidgetRenderer(c)
+
+	assert.Equal(t, "MON", c.dates.Objects[0].(*widget.Label).Text)
+	assert.Equal(t, 6, spacersBefore(c.dates.Objects, dayButton(c, 1)))
+
+	c.FirstWeekday = time.Sunday
+	c.refreshDates()
+	assert.Equal(t, "SUN", c.dates.Objects[0].(*widget.Label).Text)
+	assert.Equal(t, 0, spacersBefore(c.dates.Objects, dayButton(c, 1)))
+}
+
+func TestCalendar_WeekNumbers(t *testing.T) {
+	date := time.Date(2024, time.December, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.FirstWeekday = time.Monday
+	c.ShowWeekNumbers = true
+	_ = test.WidgetRenderer(c)
+
+	assert.Equal(t, 8, c.dates.Layout.(*calendarLayout).cols)
+	assert.Equal(t, "WK", c.dates.Objects[0].(*widget.Label).Text)
+	weeks := []string{}
+	for _, o := range c.dates.Objects[8:] {
+		if l, ok := o.(*widget.Label); ok {
+			weeks = append(weeks, l.Text)
+		}
+	}
+	// December 2024 starts on a Sunday, in week 48, and ends on a Tuesday, in week 1 of 2025
+	assert.Equal(t, []string{"48", "49", "50", "51", "52", "1"}, weeks)
+}
+
+func TestCalendar_Localize(t *testing.T) {
+	defer func(l func(string) string) { lang.Localize = l }(lang.Localize)
+	lang.Localize = func(s string) string {
+		if translated, ok := map[string]string{"March": "März", "Mon": "Mo", "Tuesday": "Dienstag"}[s]; ok {
+			return translated
+		}
+		return s
+	}
+
+	c := NewCalendar(time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC), nil)
+	c.FirstWeekday = time.Monday
+	_ = test.WidgetRenderer(c)
+	assert.Equal(t, "März 2024", c.monthLabel.Text)
+	assert.Equal(t, "MO", c.dates.Objects[0].(*widget.Label).Text)
+	assert.Equal(t, "DIE", c.dates.Objects[1].(*widget.Label).Text) // the full name is cut
+	assert.Equal(t, "WED", c.dates.Objects[2].(*widget.Label).Text)
+}
+
+func spacersBefore(objects []fyne.CanvasObject, b *widget.Button) int {
+	count := 0
+	for _, o := range objects {
+		if o == b {
+			break
+		}
+		if _, ok := o.(*layout.Spacer); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func TestCalendar_Views(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	c.MaxDate = time.Date(2031, time.June, 1, 0, 0, 0, 0, time.UTC)
+	_ = test.WidgetRenderer(c)
+
+	test.Tap(c.monthLabel)
+	assert.Equal(t, "2024", c.monthLabel.Text)
+	assert.Len(t, c.dates.Objects, 12)
+	test.Tap(c.monthLabel)
+	assert.Equal(t, "2020 - 2029", c.monthLabel.Text)
+	test.Tap(c.monthNext)
+	assert.Equal(t, "2030 - 2039", c.monthLabel.Text)
+	assert.False(t, gridButton(c, "2031").Disabled())
+	assert.True(t, gridButton(c, "2032").Disabled())
+	assert.True(t, c.monthNext.Disabled())
+	test.Tap(c.monthLabel)
+	assert.Equal(t, "2000 - 2099", c.monthLabel.Text)
+	assert.True(t, c.monthLabel.Disabled())
+
+	test.Tap(gridButton(c, "2010s"))
+	assert.Equal(t, "2010 - 2019", c.monthLabel.Text)
+	test.Tap(gridButton(c, "2016"))
+	assert.Equal(t, "2016", c.monthLabel.Text)
+	test.Tap(gridButton(c, "Feb"))
+	assert.Equal(t, "February 2016", c.monthLabel.Text)
+	assert.NotNil(t, dayButton(c, 29))
+}
+
+func TestCalendar_Keyboard(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	var selected time.Time
+	c := NewCalendar(date, func(d time.Time) { selected = d })
+	c.FirstWeekday = time.Monday
+	c.MinDate = time.Date(2024, time.February, 20, 0, 0, 0, 0, time.UTC)
+	_ = test.WidgetRenderer(c)
+
+	c.FocusGained()
+	assert.Equal(t, widget.MediumImportance, dayButton(c, 10).Importance)
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyRight})
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, 18, c.focusDate.Day())
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnd})
+	assert.Equal(t, 24, c.focusDate.Day())
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyHome})
+	assert.Equal(t, 18, c.focusDate.Day())
+
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageDown})
+	assert.Equal(t, "April 2024", c.monthLabel.Text)
+	assert.Equal(t, 18, c.focusDate.Day())
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnter})
+	assert.Equal(t, time.Date(2024, time.April, 18, 12, 0, 0, 0, time.UTC), selected)
+	assert.Equal(t, widget.HighImportance, dayButton(c, 18).Importance)
+
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyPageUp})
+	assert.Equal(t, "February 2024", c.monthLabel.Text)
+	assert.Equal(t, 20, c.focusDate.Day())
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+	assert.Equal(t, 20, c.focusDate.Day())
+
+	c.FocusLost()
+	assert.Equal(t, widget.LowImportance, dayButton(c, 20).Importance)
+}
+
+func TestCalendar_KeyboardViews(t *testing.T) {
+	date := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+	c := NewCalendar(date, nil)
+	_ = test.WidgetRenderer(c)
+
+	test.Tap(c.monthLabel)
+	c.FocusGained()
+	assert.Equal(t, widget.MediumImportance, gridButton(c, "Mar").Importance)
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, widget.MediumImportance, gridButton(c, "Jul").Importance)
+	c.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEnter})
+	assert.Equal(t, "July 2024", c.monthLabel.Text)
+}
+
+func gridButton(c *Calendar, text string) *widget.Button {
+	for _, o := range c.dates.Objects {
+		if b, ok := o.(*widget.Button); ok && b.Text == text {
+			return b
+		}
+	}
+
+	return nil
+}
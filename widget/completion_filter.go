@@ -0,0 +1,249 @@
+package widget
+
+import (
+	"sort"
+	"unicode"
+)
+
+// CompletionFilter is the way a CompletionEntry filters its options with the text typed.
+type CompletionFilter int
+
+const (
+	// CompletionFilterNone shows the options as they are set, the application filters them.
+	CompletionFilterNone CompletionFilter = iota
+	// CompletionFilterPrefix shows the options starting with the text typed.
+	CompletionFilterPrefix
+	// CompletionFilterSubstring shows the options containing the text typed.
+	CompletionFilterSubstring
+	// CompletionFilterFuzzy shows the options containing the characters typed in order, the closest first.
+	CompletionFilterFuzzy
+)
+
+const (
+	matchScore       = 16
+	boundaryBonus    = 8
+	consecutiveBonus = 12
+)
+
+// accentFolds are the base letters of the accented Latin letters, as compared when accents are ignored.
+var accentFolds = map[rune]rune{}
+
+func init() {
+	for base, accented := range map[rune]string{
+		'a': "àáâãäåāăą", 'c': "çćĉċč", 'd': "ďđ", 'e': "èéêëēĕėęě", 'g': "ĝğġģ", 'h': "ĥħ",
+		'i': "ìíîïĩīĭįı", 'j': "ĵ", 'k': "ķ", 'l': "ĺļľŀł", 'n': "ñńņňŉ", 'o': "òóôõöøōŏő",
+		'r': "ŕŗř", 's': "śŝşšș", 't': "ţťŧț", 'u': "ùúûüũūŭůűų", 'w': "ŵ", 'y': "ýÿŷ", 'z': "źżž",
+		'A': "ÀÁÂÃÄÅĀĂĄ", 'C': "ÇĆĈĊČ", 'D': "ĎĐ", 'E': "ÈÉÊËĒĔĖĘĚ", 'G': "ĜĞĠĢ", 'H': "ĤĦ",
+		'I': "ÌÍÎÏĨĪĬĮİ", 'J': "Ĵ", 'K': "Ķ", 'L': "ĹĻĽĿŁ", 'N': "ÑŃŅŇ", 'O': "ÒÓÔÕÖØŌŎŐ",
+		'R': "ŔŖŘ", 'S': "ŚŜŞŠȘ", 'T': "ŢŤŦȚ", 'U': "ÙÚÛÜŨŪŬŮŰŲ", 'W': "Ŵ", 'Y': "ÝŶŸ", 'Z': "ŹŻŽ",
+	} {
+		for _, r := range accented {
+			accentFolds[r] = base
+		}
+	}
+}
+
+// completionMatches are the options matching the text typed, ranked, with the positions of the
+// runes matched in each of them.
+type completionMatches struct {
+	items, labels []string
+	positions     [][]int
+}
+
+// foldedOptions caches the options of a CompletionEntry folded for the comparisons, with a copy of
+// the options they are folded from.
+type foldedOptions struct {
+	source                         []string
+	caseSensitive, accentSensitive bool
+	runes                          [][]rune
+}
+
+// of reports if the runes are folded from the `options`, with the same sensitivity.
+func (f *foldedOptions) of(options []string, caseSensitive, accentSensitive bool) bool {
+	if len(f.source) != len(options) || f.caseSensitive != caseSensitive || f.accentSensitive != accentSensitive {
+		return false
+	}
+	for i, option := range options {
+		if f.source[i] != option {
+			return false
+		}
+	}
+	return true
+}
+
+// foldRunes returns the runes of `text`, without case and accents unless they are significant.
+// The folded runes keep the positions of the runes of the text.
+func foldRunes(text string, caseSensitive, accentSensitive bool) []rune {
+	runes := []rune(text)
+	for i, r := range runes {
+		if !accentSensitive {
+			if base, ok := accentFolds[r]; ok {
+				r = base
+			}
+		}
+		if !caseSensitive {
+			r = unicode.ToLower(r)
+		}
+		runes[i] = r
+	}
+	return runes
+}
+
+// filterOptions returns the `options`, and their `labels`, matching the `query` with the `filter`,
+// the best matches first. The `folded` runes are those of the labels, for the options having one.
+func filterOptions(options, labels []string, folded [][]rune, query []rune, filter CompletionFilter) *completionMatches {
+	type match struct {
+		index, score int
+		positions    []int
+	}
+
+	var matches []match
+	for i, text := range folded {
+		var positions []int
+		switch filter {
+		case CompletionFilterPrefix:
+			if hasRunePrefix(text, query) {
+				positions = runeRange(0, len(query))
+			}
+		case CompletionFilterSubstring:
+			if at := indexRunes(text, query); at >= 0 {
+				positions = runeRange(at, len(query))
+			}
+		default:
+			positions = fuzzyPositions(text, query)
+		}
+		if positions != nil {
+			score := matchScoreOf([]rune(optionText(options, labels, i)), positions)
+			matches = append(matches, match{index: i, score: score, positions: positions})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return len(folded[matches[i].index]) < len(folded[matches[j].index])
+	})
+
+	result := &completionMatches{
+		items:     make([]string, len(matches)),
+		positions: make([][]int, len(matches)),
+	}
+	if labels != nil {
+		result.labels = make([]string, len(matches))
+	}
+	for i, m := range matches {
+		result.items[i], result.positions[i] = options[m.index], m.positions
+		if labels != nil && m.index < len(labels) {
+			result.labels[i] = labels[m.index]
+		}
+	}
+	return result
+}
+
+// optionText returns the text shown for the option `i`, its label if set.
+func optionText(options, labels []string, i int) string {
+	if i < len(labels) && labels[i] != "" {
+		return labels[i]
+	}
+	return options[i]
+}
+
+// fuzzyPositions returns the positions of the runes of the `query` found in order in the `text`,
+// in the shortest part of the text ending at the first complete match, or nil if not found.
+func fuzzyPositions(text, query []rune) []int {
+	if len(query) == 0 {
+		return []int{}
+	}
+
+	// find the end of the first match, then the latest start for this end
+	q, end := 0, -1
+	for i, r := range text {
+		if r == query[q] {
+			if q++; q == len(query) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return nil
+	}
+	start := end
+	for q = len(query) - 1; q >= 0; start-- {
+		if text[start] == query[q] {
+			q--
+		}
+	}
+	start++
+
+	positions := make([]int, 0, len(query))
+	for i := start; len(positions) < len(query); i++ {
+		if text[i] == query[len(positions)] {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+func hasRunePrefix(text, prefix []rune) bool {
+	if len(prefix) > len(text) {
+		return false
+	}
+	for i, r := range prefix {
+		if text[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+func indexRunes(text, sub []rune) int {
+	for i := 0; i+len(sub) <= len(text); i++ {
+		if hasRunePrefix(text[i:], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isWordStart reports if the rune at `i` starts a word of the `text`.
+func isWordStart(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, r := text[i-1], text[i]
+	return !unicode.IsLetter(prev) && !unicode.IsDigit(prev) || unicode.IsLower(prev) && unicode.IsUpper(r)
+}
+
+// matchScoreOf scores the match of the runes at `positions` in the `text` as typed, favoring the
+// consecutive runes, the starts of words and the matches close to the start.
+func matchScoreOf(text []rune, positions []int) int {
+	if len(positions) == 0 {
+		return 0
+	}
+
+	score := -positions[0]
+	for i, p := range positions {
+		score += matchScore
+		if isWordStart(text, p) {
+			score += boundaryBonus
+		}
+		if i > 0 {
+			if gap := p - positions[i-1] - 1; gap == 0 {
+				score += consecutiveBonus
+			} else {
+				score -= gap
+			}
+		}
+	}
+	return score
+}
+
+func runeRange(start, count int) []int {
+	positions := make([]int, count)
+	for i := range positions {
+		positions[i] = start + i
+	}
+	return positions
+}
@@ -1,11 +1,46 @@
 package widget
 
 import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
 )
 
+// defaultSuggestionDelay is the time without typing before the suggestions are requested.
+const defaultSuggestionDelay = 300 * time.Millisecond
+
+// Suggestion is an option returned by a SuggestionProvider.
+type Suggestion struct {
+	// Text is written in the entry when the suggestion is chosen.
+	Text string
+	// Label is shown in the list of options instead of Text, if set.
+	Label string
+}
+
+// SuggestionProvider returns the suggestions for the text `query` typed in a CompletionEntry.
+// It is called in a goroutine once the typing paused for the SuggestionDelay, so the loading row
+// only appears after that delay. The context is cancelled when the query is outdated, and a panic
+// is recovered and shown in the error row.
+type SuggestionProvider func(ctx context.Context, query string) ([]Suggestion, error)
+
+// queryResult is the state of a request of the Provider, passed back from the goroutine of the request.
+type queryResult struct {
+	ctx             context.Context
+	query           string
+	options, labels []string
+	status          string
+	importance      widget.Importance
+}
+
 // CompletionEntry is an Entry with options displayed in a PopUpMenu.
 type CompletionEntry struct {
 	widget.Entry
@@ -17,6 +52,30 @@ type CompletionEntry struct {
 
 	CustomCreate func() fyne.CanvasObject
 	CustomUpdate func(id widget.ListItemID, object fyne.CanvasObject)
+
+	// Provider, if set, fills the options with its suggestions for the text typed, once the typing
+	// paused for SuggestionDelay. A loading row, then an error row if it fails, are shown meanwhile.
+	Provider SuggestionProvider
+	// SuggestionDelay is the time without typing before the Provider is called, 300ms if not set.
+	SuggestionDelay time.Duration
+
+	// Filter shows the options matching the text typed, the best matches first, with the matched
+	// characters in bold. The options are shown as they are set by default. Suggestions with a
+	// Label are matched on the label shown.
+	Filter CompletionFilter
+	// CaseSensitive and AccentSensitive make the case and the accents significant when filtering.
+	CaseSensitive, AccentSensitive bool
+
+	// optionsLock guards the options, the popup and the status row, that are changed by the results
+	// of the Provider.
+	optionsLock sync.Mutex
+	labels      []string
+	filtered    *completionMatches
+	folded      foldedOptions
+	status      *widget.Label
+	results     binding.Untyped
+	queryTimer  *time.Timer
+	cancelQuery context.CancelFunc
 }
 
 // NewCompletionEntry creates a new CompletionEntry which creates a popup menu that responds to keystrokes to navigate through the items without losing the editing ability of the text input.
@@ -28,6 +87,12 @@ func NewCompletionEntry(options []string) *CompletionEntry {
 
 // HideCompletion hides the completion menu.
 func (c *CompletionEntry) HideCompletion() {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	c.hideCompletion()
+}
+
+func (c *CompletionEntry) hideCompletion() {
 	if c.popupMenu != nil {
 		c.popupMenu.Hide()
 	}
@@ -38,6 +103,8 @@ func (c *CompletionEntry) HideCompletion() {
 // Implements: fyne.Widget
 func (c *CompletionEntry) Move(pos fyne.Position) {
 	c.Entry.Move(pos)
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
 	if c.popupMenu != nil {
 		c.popupMenu.Resize(c.maxSize())
 		c.popupMenu.Move(c.popUpPos())
@@ -47,30 +114,51 @@ func (c *CompletionEntry) Move(pos fyne.Position) {
 // Refresh the list to update the options to display.
 func (c *CompletionEntry) Refresh() {
 	c.Entry.Refresh()
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	c.refreshList()
+}
+
+func (c *CompletionEntry) refreshList() {
 	if c.navigableList != nil {
-		c.navigableList.SetOptions(c.Options)
+		items, labels, positions := c.shown()
+		c.navigableList.labels, c.navigableList.positions = labels, positions
+		c.navigableList.SetOptions(items)
 	}
 }
 
 // SetOptions set the completion list with itemList and update the view.
 func (c *CompletionEntry) SetOptions(itemList []string) {
+	text := c.Text
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
 	c.Options = itemList
-	c.Refresh()
+	c.labels = nil
+	c.filterOptions(text)
+	c.refreshList()
 }
 
 // ShowCompletion displays the completion menu
 func (c *CompletionEntry) ShowCompletion() {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	c.showCompletion()
+}
+
+func (c *CompletionEntry) showCompletion() {
 	if c.pause {
 		return
 	}
-	if len(c.Options) == 0 {
-		c.HideCompletion()
+	items, labels, positions := c.shown()
+	if len(items) == 0 && !c.statusShown() {
+		c.hideCompletion()
 		return
 	}
 
 	if c.navigableList == nil {
-		c.navigableList = newNavigableList(c.Options, &c.Entry, c.setTextFromMenu, c.HideCompletion,
+		c.navigableList = newNavigableList(items, c, c.setTextFromMenu, c.HideCompletion,
 			c.CustomCreate, c.CustomUpdate)
+		c.navigableList.labels, c.navigableList.positions = labels, positions
 	} else {
 		c.navigableList.UnselectAll()
 		c.navigableList.selected = -1
@@ -78,7 +166,8 @@ func (c *CompletionEntry) ShowCompletion() {
 	holder := fyne.CurrentApp().Driver().CanvasForObject(c)
 
 	if c.popupMenu == nil {
-		c.popupMenu = widget.NewPopUp(c.navigableList, holder)
+		c.createStatus()
+		c.popupMenu = widget.NewPopUp(container.NewBorder(c.status, nil, nil, nil, c.navigableList), holder)
 	}
 	c.popupMenu.Resize(c.maxSize())
 	c.popupMenu.ShowAtPosition(c.popUpPos())
@@ -94,7 +183,12 @@ func (c *CompletionEntry) maxSize() fyne.Size {
 		c.itemHeight = c.navigableList.CreateItem().MinSize().Height
 	}
 
-	listheight := float32(len(c.Options))*(c.itemHeight+2*theme.Padding()+theme.SeparatorThicknessSize()) + 2*theme.Padding()
+	items, _, _ := c.shown()
+	rows := len(items)
+	if c.statusShown() {
+		rows++
+	}
+	listheight := float32(rows)*(c.itemHeight+2*theme.Padding()+theme.SeparatorThicknessSize()) + 2*theme.Padding()
 	canvasSize := cnv.Size()
 	entrySize := c.Size()
 	if canvasSize.Height > listheight {
@@ -106,6 +200,205 @@ func (c *CompletionEntry) maxSize() fyne.Size {
 		canvasSize.Height-c.Position().Y-entrySize.Height-theme.InputBorderSize()-theme.Padding())
 }
 
+// TypedKey receives key input events when the entry is focused, and requests suggestions when the
+// text changes.
+//
+// Implements: fyne.Focusable
+func (c *CompletionEntry) TypedKey(key *fyne.KeyEvent) {
+	text := c.Text
+	c.Entry.TypedKey(key)
+	if c.Text != text {
+		c.query()
+	}
+}
+
+// TypedRune receives text input events when the entry is focused, and requests suggestions.
+//
+// Implements: fyne.Focusable
+func (c *CompletionEntry) TypedRune(r rune) {
+	text := c.Text
+	c.Entry.TypedRune(r)
+	if c.Text != text {
+		c.query()
+	}
+}
+
+// TypedShortcut handles the registered shortcuts, and requests suggestions when the text changes.
+//
+// Implements: fyne.Shortcutable
+func (c *CompletionEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	text := c.Text
+	c.Entry.TypedShortcut(shortcut)
+	if c.Text != text {
+		c.query()
+	}
+}
+
+// applyResult shows the last state passed back by a request of the Provider, unless it is outdated.
+func (c *CompletionEntry) applyResult() {
+	value, _ := c.results.Get()
+	result, _ := value.(*queryResult)
+	if result == nil {
+		return
+	}
+
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	if result.ctx.Err() != nil {
+		return
+	}
+
+	if result.status != "" {
+		c.status.Text, c.status.Importance = result.status, result.importance
+		c.status.Show()
+		c.status.Refresh()
+	} else {
+		c.status.Hide()
+		c.Options, c.labels = result.options, result.labels
+		c.filterOptions(result.query)
+		c.refreshList()
+	}
+	c.showCompletion()
+}
+
+// createStatus creates the loading and error row, hidden.
+func (c *CompletionEntry) createStatus() {
+	if c.status == nil {
+		c.status = widget.NewLabel("")
+		c.status.Hide()
+	}
+}
+
+// fetch calls the Provider for the `query`, passing back a loading state until the suggestions are
+// received, then the suggestions or the error. The results are shown by applyResult.
+func (c *CompletionEntry) fetch(ctx context.Context, query string) {
+	c.results.Set(&queryResult{ctx: ctx, query: query, status: lang.Localize("Loading…"), importance: widget.LowImportance})
+
+	suggestions, err := c.suggest(ctx, query)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		c.results.Set(&queryResult{ctx: ctx, query: query, status: err.Error(), importance: widget.DangerImportance})
+		return
+	}
+
+	result := &queryResult{ctx: ctx, query: query,
+		options: make([]string, len(suggestions)), labels: make([]string, len(suggestions))}
+	for i, s := range suggestions {
+		result.options[i], result.labels[i] = s.Text, s.Label
+	}
+	c.results.Set(result)
+}
+
+// suggest calls the Provider, returning its panic as an error.
+func (c *CompletionEntry) suggest(ctx context.Context, query string) (suggestions []Suggestion, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return c.Provider(ctx, query)
+}
+
+// query requests the suggestions of the Provider for the text, once the typing paused, cancelling
+// the previous request.
+func (c *CompletionEntry) query() {
+	text := c.Text
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	if c.pause {
+		return
+	}
+	if c.Filter != CompletionFilterNone {
+		c.filterOptions(text)
+		c.refreshList()
+		if text == "" {
+			c.hideCompletion()
+		} else {
+			c.showCompletion()
+		}
+	}
+	if c.Provider == nil {
+		return
+	}
+
+	if c.cancelQuery != nil {
+		c.cancelQuery()
+	}
+	if c.queryTimer != nil {
+		c.queryTimer.Stop()
+	}
+	if text == "" {
+		c.cancelQuery = nil
+		if c.status != nil {
+			c.status.Hide()
+		}
+		c.hideCompletion()
+		return
+	}
+
+	if c.results == nil {
+		c.createStatus()
+		c.results = binding.NewUntyped()
+		c.results.AddListener(binding.NewDataListener(c.applyResult))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	delay := c.SuggestionDelay
+	if delay <= 0 {
+		delay = defaultSuggestionDelay
+	}
+	c.cancelQuery = cancel
+	c.queryTimer = time.AfterFunc(delay, func() {
+		c.fetch(ctx, text)
+	})
+}
+
+// filterOptions filters the options with the `text` typed, if a Filter is set. The options, or their
+// labels if set, are folded once for the comparisons, until they are changed, even in place.
+func (c *CompletionEntry) filterOptions(text string) {
+	if c.Filter == CompletionFilterNone {
+		c.filtered = nil
+		return
+	}
+
+	shown := make([]string, len(c.Options))
+	for i := range c.Options {
+		shown[i] = optionText(c.Options, c.labels, i)
+	}
+	f := &c.folded
+	if !f.of(shown, c.CaseSensitive, c.AccentSensitive) {
+		*f = foldedOptions{source: shown, caseSensitive: c.CaseSensitive, accentSensitive: c.AccentSensitive}
+		f.runes = make([][]rune, len(shown))
+		for i, option := range shown {
+			f.runes[i] = foldRunes(option, c.CaseSensitive, c.AccentSensitive)
+		}
+	}
+
+	query := foldRunes(text, c.CaseSensitive, c.AccentSensitive)
+	c.filtered = filterOptions(c.Options, c.labels, f.runes, query, c.Filter)
+}
+
+// shown returns the options shown in the list, with their labels and the positions of the
+// characters matching the text typed if they are filtered.
+func (c *CompletionEntry) shown() (items, labels []string, positions [][]int) {
+	if c.filtered == nil {
+		return c.Options, c.labels, nil
+	}
+	return c.filtered.items, c.filtered.labels, c.filtered.positions
+}
+
+// showingStatus reports if the loading or error row is shown.
+func (c *CompletionEntry) showingStatus() bool {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	return c.statusShown()
+}
+
+func (c *CompletionEntry) statusShown() bool {
+	return c.status != nil && c.status.Visible()
+}
+
 // calculate where the popup should appear
 func (c *CompletionEntry) popUpPos() fyne.Position {
 	entryPos := fyne.CurrentApp().Driver().AbsolutePositionForObject(c)
@@ -114,28 +407,36 @@ func (c *CompletionEntry) popUpPos() fyne.Position {
 
 // Prevent the menu to open when the user validate value from the menu.
 func (c *CompletionEntry) setTextFromMenu(s string) {
-	c.pause = true
+	c.setPause(true)
 	c.Entry.SetText(s)
 	c.Entry.CursorColumn = len([]rune(s))
 	c.Entry.Refresh()
-	c.pause = false
-	c.popupMenu.Hide()
+	c.setPause(false)
+	c.HideCompletion()
+}
+
+func (c *CompletionEntry) setPause(pause bool) {
+	c.optionsLock.Lock()
+	defer c.optionsLock.Unlock()
+	c.pause = pause
 }
 
 type navigableList struct {
 	widget.List
-	entry           *widget.Entry
+	entry           fyne.Focusable
 	selected        int
 	setTextFromMenu func(string)
 	hide            func()
 	navigating      bool
 	items           []string
+	labels          []string
+	positions       [][]int
 
 	customCreate func() fyne.CanvasObject
 	customUpdate func(id widget.ListItemID, object fyne.CanvasObject)
 }
 
-func newNavigableList(items []string, entry *widget.Entry, setTextFromMenu func(string), hide func(),
+func newNavigableList(items []string, entry fyne.Focusable, setTextFromMenu func(string), hide func(),
 	create func() fyne.CanvasObject, update func(id widget.ListItemID, object fyne.CanvasObject)) *navigableList {
 	n := &navigableList{
 		entry:           entry,
@@ -155,14 +456,21 @@ func newNavigableList(items []string, entry *widget.Entry, setTextFromMenu func(
 			if fn := n.customCreate; fn != nil {
 				return fn()
 			}
-			return widget.NewLabel("")
+			return widget.NewRichText(&widget.TextSegment{Style: widget.RichTextStyleInline})
 		},
 		UpdateItem: func(i widget.ListItemID, o fyne.CanvasObject) {
 			if fn := n.customUpdate; fn != nil {
 				fn(i, o)
 				return
 			}
-			o.(*widget.Label).SetText(n.items[i])
+			text, positions := optionText(n.items, n.labels, i), []int(nil)
+			if i < len(n.positions) {
+				positions = n.positions[i]
+			}
+
+			item := o.(*widget.RichText)
+			item.Segments = highlightSegments(text, positions)
+			item.Refresh()
 		},
 		OnSelected: func(id widget.ListItemID) {
 			if !n.navigating && id > -1 {
@@ -228,3 +536,25 @@ func (n *navigableList) TypedKey(event *fyne.KeyEvent) {
 func (n *navigableList) TypedRune(r rune) {
 	n.entry.TypedRune(r)
 }
+
+// highlightSegments returns the segments of the `text`, with the runes at `positions` in bold.
+func highlightSegments(text string, positions []int) []widget.RichTextSegment {
+	runes := []rune(text)
+	segments := []widget.RichTextSegment{}
+	for start, p := 0, 0; start < len(runes) || len(segments) == 0; {
+		bold := p < len(positions) && positions[p] == start
+		end := start
+		for end < len(runes) && (p < len(positions) && positions[p] == end) == bold {
+			if bold {
+				p++
+			}
+			end++
+		}
+
+		style := widget.RichTextStyleInline
+		style.TextStyle.Bold = bold
+		segments = append(segments, &widget.TextSegment{Text: string(runes[start:end]), Style: style})
+		start = end
+	}
+	return segments
+}
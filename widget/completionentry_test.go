@@ -1,7 +1,16 @@
 package widget
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/test"
@@ -229,3 +238,256 @@ func TestCompletionEntry_DoubleSubmissionIssue(t *testing.T) {
 	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn}) // OnSubmitted should be called
 	assert.True(t, submitted)
 }
+
+// Check the suggestions of a provider backed by a local HTTP server, and the cancellation of stale
+// requests.
+func TestCompletionEntry_Provider(t *testing.T) {
+	var lock sync.Mutex
+	var cancelled []string
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		received <- query
+		if query == "fail" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-r.Context().Done():
+			lock.Lock()
+			cancelled = append(cancelled, query)
+			lock.Unlock()
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]string{query + "1", query + "2"})
+	}))
+	defer server.Close()
+
+	entry := NewCompletionEntry(nil)
+	entry.SuggestionDelay = 10 * time.Millisecond
+	entry.Provider = func(ctx context.Context, query string) ([]Suggestion, error) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?q="+url.QueryEscape(query), nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.New(resp.Status)
+		}
+
+		var options []string
+		err = json.NewDecoder(resp.Body).Decode(&options)
+		suggestions := make([]Suggestion, len(options))
+		for i, o := range options {
+			suggestions[i] = Suggestion{Text: o, Label: "> " + o}
+		}
+		return suggestions, err
+	}
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "a")
+	assert.Eventually(t, func() bool {
+		text, _ := completionStatus(entry)
+		return text == "Loading…"
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, "a", <-received)
+	win.Canvas().Focused().TypedRune('b') // cancels the request of "a"
+	assert.Eventually(t, func() bool {
+		entry.optionsLock.Lock()
+		defer entry.optionsLock.Unlock()
+		return len(entry.Options) == 2 && entry.Options[0] == "ab1"
+	}, time.Second, 5*time.Millisecond)
+	assert.False(t, entry.showingStatus())
+	entry.optionsLock.Lock()
+	assert.True(t, entry.popupMenu.Visible())
+	entry.optionsLock.Unlock()
+	item := entry.navigableList.CreateItem()
+	entry.navigableList.UpdateItem(0, item)
+	assert.Equal(t, "> ab1", item.(*widget.RichText).String())
+	assert.Eventually(t, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(cancelled) == 1 && cancelled[0] == "a"
+	}, time.Second, 5*time.Millisecond)
+
+	entry.SetText("")
+	entry.CursorColumn = 0
+	test.Type(entry, "fail")
+	assert.Eventually(t, func() bool {
+		_, importance := completionStatus(entry)
+		return importance == widget.DangerImportance
+	}, time.Second, 5*time.Millisecond)
+	text, _ := completionStatus(entry)
+	assert.Contains(t, text, "503")
+}
+
+func TestCompletionEntry_ProviderPanic(t *testing.T) {
+	entry := NewCompletionEntry(nil)
+	entry.SuggestionDelay = 10 * time.Millisecond
+	entry.Provider = func(context.Context, string) ([]Suggestion, error) {
+		panic("no index")
+	}
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "a")
+	assert.Eventually(t, func() bool {
+		_, importance := completionStatus(entry)
+		return importance == widget.DangerImportance
+	}, time.Second, 5*time.Millisecond)
+	text, _ := completionStatus(entry)
+	assert.Equal(t, "no index", text)
+}
+
+func TestCompletionEntry_FilterLabels(t *testing.T) {
+	entry := NewCompletionEntry(nil)
+	entry.Filter = CompletionFilterPrefix
+	entry.SuggestionDelay = 10 * time.Millisecond
+	entry.Provider = func(context.Context, string) ([]Suggestion, error) {
+		return []Suggestion{{Text: "FR", Label: "France"}, {Text: "DE", Label: "Germany"},
+			{Text: "GR", Label: "Greece"}}, nil
+	}
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "g")
+	assert.Eventually(t, func() bool {
+		entry.optionsLock.Lock()
+		defer entry.optionsLock.Unlock()
+		return len(entry.Options) == 3
+	}, time.Second, 5*time.Millisecond)
+	entry.optionsLock.Lock()
+	assert.Equal(t, []string{"GR", "DE"}, entry.navigableList.items) // matched on the labels, shortest first
+	entry.optionsLock.Unlock()
+
+	item := entry.navigableList.CreateItem()
+	entry.navigableList.UpdateItem(0, item)
+	segments := item.(*widget.RichText).Segments
+	assert.Equal(t, 2, len(segments))
+	for i, text := range []string{"G", "reece"} {
+		segment := segments[i].(*widget.TextSegment)
+		assert.Equal(t, text, segment.Text)
+		assert.Equal(t, i == 0, segment.Style.TextStyle.Bold)
+	}
+}
+
+func TestCompletionEntry_Filter(t *testing.T) {
+	entry := NewCompletionEntry([]string{"Apple pie", "Pineapple", "Papaya", "Éclair", "eclipse", "pear"})
+	entry.Filter = CompletionFilterPrefix
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "p")
+	assert.Equal(t, []string{"pear", "Papaya", "Pineapple"}, entry.navigableList.items)
+	assert.True(t, entry.popupMenu.Visible())
+
+	entry.Filter = CompletionFilterSubstring
+	test.Type(entry, "p")
+	assert.Equal(t, []string{"Apple pie", "Pineapple"}, entry.navigableList.items)
+	assert.Equal(t, [][]int{{1, 2}, {5, 6}}, entry.navigableList.positions)
+
+	entry.Filter = CompletionFilterFuzzy
+	entry.SetText("")
+	entry.CursorColumn = 0
+	test.Type(entry, "ecl")
+	assert.Equal(t, []string{"Éclair", "eclipse"}, entry.navigableList.items)
+
+	entry.AccentSensitive = true
+	test.Type(entry, "i")
+	assert.Equal(t, []string{"eclipse"}, entry.navigableList.items)
+
+	entry.CaseSensitive = true
+	entry.SetText("")
+	entry.CursorColumn = 0
+	test.Type(entry, "P")
+	assert.Equal(t, []string{"Papaya", "Pineapple"}, entry.navigableList.items)
+
+	test.Type(entry, "z")
+	assert.False(t, entry.popupMenu.Visible())
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.True(t, entry.popupMenu.Visible())
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.False(t, entry.popupMenu.Visible())
+}
+
+func TestCompletionEntry_FilterRanking(t *testing.T) {
+	entry := NewCompletionEntry([]string{"the_color_bar", "colorbar", "cool_bars", "ColorBar"})
+	entry.Filter = CompletionFilterFuzzy
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "cb")
+	assert.Equal(t, []string{"ColorBar", "cool_bars", "the_color_bar", "colorbar"}, entry.navigableList.items)
+
+	item := entry.navigableList.CreateItem()
+	entry.navigableList.UpdateItem(0, item)
+	segments := item.(*widget.RichText).Segments
+	assert.Equal(t, 4, len(segments))
+	for i, text := range []string{"C", "olor", "B", "ar"} {
+		segment := segments[i].(*widget.TextSegment)
+		assert.Equal(t, text, segment.Text)
+		assert.Equal(t, i%2 == 0, segment.Style.TextStyle.Bold)
+	}
+
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	win.Canvas().Focused().TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "ColorBar", entry.Text)
+}
+
+func TestCompletionEntry_FilterOptionsChanged(t *testing.T) {
+	options := make([]string, 1000)
+	for i := range options {
+		options[i] = fmt.Sprintf("option %d", i)
+	}
+	entry := NewCompletionEntry(options)
+	entry.Filter = CompletionFilterFuzzy
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+
+	test.Type(entry, "on49")
+	assert.Equal(t, "option 49", entry.navigableList.items[0])
+	assert.Equal(t, "option 490", entry.navigableList.items[1])
+
+	options[49] = "other"
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	test.Type(entry, "9")
+	assert.Equal(t, "option 490", entry.navigableList.items[0])
+}
+
+func BenchmarkCompletionEntry_FilterLargeOptions(b *testing.B) {
+	options := make([]string, 50000)
+	for i := range options {
+		options[i] = fmt.Sprintf("option %d", i)
+	}
+	entry := NewCompletionEntry(options)
+	entry.Filter = CompletionFilterFuzzy
+	win := test.NewWindow(entry)
+	win.Resize(fyne.NewSize(500, 300))
+	defer win.Close()
+	test.Type(entry, "o")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		test.Type(entry, "n49")
+		entry.SetText("o")
+	}
+}
+
+// completionStatus returns the text and importance of the status row of the entry, if it is shown.
+func completionStatus(entry *CompletionEntry) (string, widget.Importance) {
+	entry.optionsLock.Lock()
+	defer entry.optionsLock.Unlock()
+	if !entry.statusShown() {
+		return "", widget.MediumImportance
+	}
+	return entry.status.Text, entry.status.Importance
+}
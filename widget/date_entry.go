@@ -0,0 +1,394 @@
+package widget
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
+)
+
+const defaultDateLayout = "2006-01-02"
+
+var (
+	errInvalidDate    = errors.New("invalid date")
+	errDateOutOfRange = errors.New("date out of range")
+)
+
+// DateEntry is an extended entry for dates, that are typed in the layout of the entry or picked in
+// a Calendar shown by the button of the entry. The entry is invalid if the text is not a date, or if
+// the date is out of the bounds.
+type DateEntry struct {
+	widget.Entry
+
+	// Layout is the layout of the dates, as defined by the time package, "2006-01-02" by default.
+	Layout string
+	// MinDate and MaxDate are the first and last valid dates, if not zero.
+	MinDate, MaxDate time.Time
+	// Location is the time zone of the dates, the local time zone if nil.
+	Location *time.Location
+	// OnDateChanged is called when a valid date is typed or picked, with the zero time if the entry is cleared.
+	OnDateChanged func(time.Time)
+
+	date       time.Time
+	dateTime   *DateTimeEntry
+	popUp      *widget.PopUp
+	calendar   *Calendar
+	timePicker *TimePicker
+	bound      *dateString
+}
+
+// TimeBinding is the data binding to a time edited by a DateEntry, the zero time meaning no value.
+// It is implemented by the Time binding of the fyne.io/x/fyne/data/binding package.
+type TimeBinding interface {
+	binding.DataItem
+	Get() (time.Time, error)
+	Set(time.Time) error
+}
+
+// DateTimeEntry is a DateEntry for dates with a time, picked with a Calendar and a TimePicker.
+// The layout of the dates is "2006-01-02 15:04" by default, with the seconds and the 12 hour clock
+// if they are enabled.
+type DateTimeEntry struct {
+	DateEntry
+
+	// ShowSeconds allows editing the seconds in the picker, and writes them in the default layout.
+	ShowSeconds bool
+	// Use12Hour uses the 12 hour clock in the picker and in the default layout.
+	Use12Hour bool
+	// Locations are the time zones that can be selected in the picker.
+	Locations []*time.Location
+}
+
+// NewDateEntry returns an entry for dates.
+func NewDateEntry() *DateEntry {
+	e := &DateEntry{}
+	e.ExtendBaseWidget(e)
+	e.Validator = e.validate
+	return e
+}
+
+// NewDateEntryWithData returns an entry for dates bound to the `data` string, holding the dates in
+// the layout of the entry.
+func NewDateEntryWithData(data binding.String) *DateEntry {
+	e := NewDateEntry()
+	e.Bind(data)
+	return e
+}
+
+// NewDateEntryWithTime returns an entry for dates bound to the `data` time.
+// The entry is bound when it is shown, and writes the dates in the layout and location set at that
+// time, or when a date is set.
+func NewDateEntryWithTime(data TimeBinding) *DateEntry {
+	e := NewDateEntry()
+	e.bound = &dateString{data: data}
+	return e
+}
+
+// NewDateTimeEntry returns an entry for dates with a time.
+func NewDateTimeEntry() *DateTimeEntry {
+	e := &DateTimeEntry{}
+	e.dateTime = e
+	e.ExtendBaseWidget(e)
+	e.Validator = e.validate
+	return e
+}
+
+// NewDateTimeEntryWithData returns an entry for dates with a time bound to the `data` string,
+// holding the dates in the layout of the entry.
+func NewDateTimeEntryWithData(data binding.String) *DateTimeEntry {
+	e := NewDateTimeEntry()
+	e.Bind(data)
+	return e
+}
+
+// NewDateTimeEntryWithTime returns an entry for dates with a time bound to the `data` time.
+// The entry is bound when it is shown, and writes the dates in the layout and location set at that
+// time, or when a date is set.
+func NewDateTimeEntryWithTime(data TimeBinding) *DateTimeEntry {
+	e := NewDateTimeEntry()
+	e.bound = &dateString{data: data}
+	return e
+}
+
+// Bind connects the entry to the `data` string, holding the dates in the layout of the entry.
+func (e *DateEntry) Bind(data binding.String) {
+	e.Entry.Bind(data)
+	e.Validator = e.validate
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (e *DateEntry) CreateRenderer() fyne.WidgetRenderer {
+	if e.ActionItem == nil {
+		picker := widget.NewButtonWithIcon("", theme.MenuDropDownIcon(), e.ShowPicker)
+		picker.Importance = widget.LowImportance
+		e.ActionItem = picker
+	}
+	if e.bound == nil {
+		return e.Entry.CreateRenderer()
+	}
+
+	// the data is formatted in the goroutine of the bindings, with a copy of the fields
+	var r fyne.WidgetRenderer
+	e.bound.bind(func() {
+		e.bound.setFormat(e.formatFields())
+		e.Bind(e.bound)
+		r = e.Entry.CreateRenderer()
+	})
+	return r
+}
+
+// Date returns the date written in the entry, the zero time if the entry is empty, or an error if
+// the text is not a valid date.
+func (e *DateEntry) Date() (time.Time, error) {
+	return e.parse(e.Text)
+}
+
+// SetDate writes the date `t` in the entry, or clears the entry if `t` is the zero time.
+func (e *DateEntry) SetDate(t time.Time) {
+	if e.bound != nil {
+		e.bound.setFormat(e.formatFields())
+	}
+	e.SetText(e.format(t))
+	e.changed()
+}
+
+// ShowPicker shows a Calendar below the entry to pick the date, with a TimePicker for a DateTimeEntry.
+func (e *DateEntry) ShowPicker() {
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(e.object())
+	if canvas == nil || e.Disabled() {
+		return
+	}
+
+	parsed, err := e.Date()
+	date := parsed
+	if err != nil || date.IsZero() {
+		now := time.Now().In(e.location())
+		date = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		if e.dateTime != nil {
+			date = now.Truncate(time.Minute)
+		}
+	}
+
+	e.calendar = NewCalendar(date, func(d time.Time) {
+		e.popUp.Hide()
+		e.SetDate(d)
+	})
+	e.calendar.MinDate, e.calendar.MaxDate = e.MinDate, e.MaxDate
+	if err == nil && !parsed.IsZero() {
+		e.calendar.SetSelection(date)
+	}
+
+	var content fyne.CanvasObject = e.calendar
+	if e.dateTime != nil {
+		content = e.dateTime.pickerContent(date)
+	}
+	e.popUp = widget.NewPopUp(content, canvas)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(e.object())
+	e.popUp.ShowAtPosition(pos.Add(fyne.NewPos(0, e.Size().Height)))
+}
+
+// TypedKey receives key input events when the entry is focused.
+//
+// Implements: fyne.Focusable
+func (e *DateEntry) TypedKey(key *fyne.KeyEvent) {
+	e.Entry.TypedKey(key)
+	e.changed()
+}
+
+// TypedRune receives text input events when the entry is focused.
+//
+// Implements: fyne.Focusable
+func (e *DateEntry) TypedRune(r rune) {
+	e.Entry.TypedRune(r)
+	e.changed()
+}
+
+// TypedShortcut handles the registered shortcuts.
+//
+// Implements: fyne.Shortcutable
+func (e *DateEntry) TypedShortcut(shortcut fyne.Shortcut) {
+	e.Entry.TypedShortcut(shortcut)
+	e.changed()
+}
+
+// changed calls OnDateChanged if the text holds a new valid date.
+func (e *DateEntry) changed() {
+	d, err := e.Date()
+	if err != nil || d.Equal(e.date) {
+		return
+	}
+
+	e.date = d
+	if e.OnDateChanged != nil {
+		e.OnDateChanged(d)
+	}
+}
+
+func (e *DateEntry) format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(e.location()).Format(e.layout())
+}
+
+// formatFields returns a copy of the fields of the entry formatting and parsing the dates, that
+// can be used from the goroutine of the data bindings.
+func (e *DateEntry) formatFields() *DateEntry {
+	if e.dateTime == nil {
+		return &DateEntry{Layout: e.layout(), Location: e.location(), MinDate: e.MinDate, MaxDate: e.MaxDate}
+	}
+
+	format := &DateTimeEntry{}
+	format.dateTime = format
+	format.Layout, format.Location = e.layout(), e.location()
+	format.MinDate, format.MaxDate = e.MinDate, e.MaxDate
+	return &format.DateEntry
+}
+
+func (e *DateEntry) layout() string {
+	if e.Layout != "" {
+		return e.Layout
+	}
+	if e.dateTime != nil {
+		return e.dateTime.defaultLayout()
+	}
+	return defaultDateLayout
+}
+
+func (e *DateEntry) location() *time.Location {
+	if e.Location == nil {
+		return time.Local
+	}
+	return e.Location
+}
+
+// object returns the widget embedding the entry, as known by the canvas.
+func (e *DateEntry) object() fyne.CanvasObject {
+	if e.dateTime != nil {
+		return e.dateTime
+	}
+	return e
+}
+
+// outOfRange reports if `t` is out of the bounds, compared by day for a DateEntry.
+func (e *DateEntry) outOfRange(t time.Time) bool {
+	if e.dateTime == nil {
+		return outOfDays(t, e.MinDate, e.MaxDate)
+	}
+	return !e.MinDate.IsZero() && t.Before(e.MinDate) || !e.MaxDate.IsZero() && t.After(e.MaxDate)
+}
+
+func (e *DateEntry) parse(text string) (time.Time, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.ParseInLocation(e.layout(), text, e.location())
+	if err != nil {
+		return time.Time{}, errInvalidDate
+	}
+	if e.outOfRange(t) {
+		return time.Time{}, errDateOutOfRange
+	}
+	return t, nil
+}
+
+func (e *DateEntry) validate(text string) error {
+	_, err := e.parse(text)
+	return err
+}
+
+func (e *DateTimeEntry) defaultLayout() string {
+	clock := "15:04"
+	if e.Use12Hour {
+		clock = "03:04"
+	}
+	if e.ShowSeconds {
+		clock += ":05"
+	}
+	if e.Use12Hour {
+		clock += " PM"
+	}
+	return defaultDateLayout + " " + clock
+}
+
+// pickerContent returns the calendar and the time picker of the popup, with a button to confirm
+// the date and time picked, starting at `date`.
+func (e *DateTimeEntry) pickerContent(date time.Time) fyne.CanvasObject {
+	picked := date
+	e.timePicker = NewTimePicker(date, func(t time.Time) {
+		picked = t
+	})
+	e.timePicker.ShowSeconds, e.timePicker.Use12Hour = e.ShowSeconds, e.Use12Hour
+	e.timePicker.Locations = e.Locations
+
+	e.calendar.onSelected = func(d time.Time) {
+		picked = time.Date(d.Year(), d.Month(), d.Day(), picked.Hour(), picked.Minute(), picked.Second(), 0,
+			picked.Location())
+		e.timePicker.SetTime(picked)
+	}
+
+	ok := widget.NewButton(lang.Localize("OK"), func() {
+		e.popUp.Hide()
+		if len(e.Locations) > 0 {
+			e.Location = picked.Location()
+		}
+		e.SetDate(picked)
+	})
+	ok.Importance = widget.HighImportance
+	return container.NewVBox(e.calendar, container.NewCenter(e.timePicker), ok)
+}
+
+// dateString adapts a Time binding to the String binding of the entry, using a copy of the layout
+// of the entry, as it is used in the goroutine of the data bindings.
+type dateString struct {
+	entryBinding
+	data TimeBinding
+
+	lock   sync.Mutex
+	format *DateEntry
+}
+
+func (s *dateString) AddListener(l binding.DataListener) {
+	s.addListener(s.data, l)
+}
+
+func (s *dateString) Get() (string, error) {
+	t, err := s.data.Get()
+	return s.formatFields().format(t), err
+}
+
+func (s *dateString) RemoveListener(l binding.DataListener) {
+	s.removeListener(s.data, l)
+}
+
+func (s *dateString) Set(text string) error {
+	t, err := s.formatFields().parse(text)
+	if err != nil {
+		return err
+	}
+	return s.data.Set(t)
+}
+
+func (s *dateString) formatFields() *DateEntry {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.format
+}
+
+func (s *dateString) setFormat(format *DateEntry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.format = format
+}
@@ -0,0 +1,96 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+	"github.com/stretchr/testify/assert"
+
+	xbinding "fyne.io/x/fyne/data/binding"
+)
+
+func TestDateEntry_Typed(t *testing.T) {
+	entry := NewDateEntry()
+	entry.Location = time.UTC
+	entry.MinDate = time.Date(2024, time.March, 5, 12, 0, 0, 0, time.UTC)
+	var changed time.Time
+	entry.OnDateChanged = func(d time.Time) { changed = d }
+
+	test.Type(entry, "2024-03-1")
+	assert.Error(t, entry.Validate())
+	assert.True(t, changed.IsZero())
+	test.Type(entry, "0")
+	assert.NoError(t, entry.Validate())
+	assert.Equal(t, time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), changed)
+
+	entry.SetText("2024-03-05")
+	assert.NoError(t, entry.Validate()) // the day of MinDate is valid
+	entry.SetText("2024-03-04")
+	assert.Equal(t, errDateOutOfRange, entry.Validate())
+
+	entry.Layout = "02/01/2006"
+	entry.SetDate(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "01/04/2024", entry.Text)
+	d, err := entry.Date()
+	assert.NoError(t, err)
+	assert.Equal(t, time.April, d.Month())
+}
+
+func TestDateEntry_Picker(t *testing.T) {
+	entry := NewDateEntry()
+	entry.Location = time.UTC
+	w := test.NewWindow(entry)
+	defer w.Close()
+
+	entry.SetDate(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC))
+	entry.ShowPicker()
+	assert.True(t, entry.popUp.Visible())
+	assert.Equal(t, "March 2024", entry.calendar.monthLabel.Text)
+
+	test.Tap(dayButton(entry.calendar, 21))
+	assert.False(t, entry.popUp.Visible())
+	assert.Equal(t, "2024-03-21", entry.Text)
+}
+
+func TestDateEntry_Time(t *testing.T) {
+	data := xbinding.NewTime()
+	_ = data.Set(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC))
+	entry := NewDateEntryWithTime(data)
+	entry.Location = time.UTC
+	test.WidgetRenderer(entry)
+	waitForBindings()
+	assert.Equal(t, "2024-03-10", entry.Text)
+
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	entry.Location = zone // the picker sets the location of the bound entry
+	entry.SetDate(time.Date(2024, time.May, 1, 23, 0, 0, 0, time.UTC))
+	waitForBindings()
+	assert.Equal(t, "2024-05-02", entry.Text)
+	d, _ := data.Get()
+	assert.True(t, time.Date(2024, time.May, 2, 0, 0, 0, 0, zone).Equal(d))
+}
+
+func TestDateTimeEntry(t *testing.T) {
+	entry := NewDateTimeEntry()
+	entry.Location = time.UTC
+	w := test.NewWindow(entry)
+	defer w.Close()
+
+	entry.SetDate(time.Date(2024, time.March, 10, 14, 30, 0, 0, time.UTC))
+	assert.Equal(t, "2024-03-10 14:30", entry.Text)
+	entry.Use12Hour = true
+	entry.SetDate(time.Date(2024, time.March, 10, 14, 30, 0, 0, time.UTC))
+	assert.Equal(t, "2024-03-10 02:30 PM", entry.Text)
+
+	entry.ShowPicker()
+	test.Tap(dayButton(entry.calendar, 12))
+	assert.True(t, entry.popUp.Visible())
+	entry.timePicker.hour.SetText("9")
+	entry.timePicker.period.SetSelectedIndex(0)
+	test.Tap(entry.popUp.Content.(*fyne.Container).Objects[2].(*widget.Button))
+	assert.False(t, entry.popUp.Visible())
+	assert.Equal(t, "2024-03-12 09:30 AM", entry.Text)
+}
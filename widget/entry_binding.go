@@ -0,0 +1,49 @@
+package widget
+
+import (
+	"sync"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// entryBinding delivers the changes of a data item to the listeners of an entry once the entry
+// is bound and its renderer created, as both write fields of the entry that the listeners read.
+type entryBinding struct {
+	listenersLock sync.Mutex
+	listeners     map[binding.DataListener]binding.DataListener
+
+	binding sync.Mutex
+}
+
+func (b *entryBinding) addListener(data binding.DataItem, l binding.DataListener) {
+	wrapped := binding.NewDataListener(func() {
+		b.binding.Lock()
+		b.binding.Unlock()
+		l.DataChanged()
+	})
+
+	b.listenersLock.Lock()
+	if b.listeners == nil {
+		b.listeners = make(map[binding.DataListener]binding.DataListener)
+	}
+	b.listeners[l] = wrapped
+	b.listenersLock.Unlock()
+	data.AddListener(wrapped)
+}
+
+// bind calls `f`, that binds the entry and creates its renderer, before any change is delivered.
+func (b *entryBinding) bind(f func()) {
+	b.binding.Lock()
+	defer b.binding.Unlock()
+	f()
+}
+
+func (b *entryBinding) removeListener(data binding.DataItem, l binding.DataListener) {
+	b.listenersLock.Lock()
+	wrapped, ok := b.listeners[l]
+	delete(b.listeners, l)
+	b.listenersLock.Unlock()
+	if ok {
+		data.RemoveListener(wrapped)
+	}
+}
@@ -0,0 +1,71 @@
+package widget
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"fyne.io/x/fyne/lang"
+)
+
+// sundayFirstRegions and saturdayFirstRegions are the regions where weeks do not start on Monday.
+var (
+	sundayFirstRegions = []string{"ag", "as", "bd", "br", "bs", "bt", "bw", "bz", "ca", "cn", "co",
+		"dm", "do", "et", "gt", "gu", "hk", "hn", "id", "il", "in", "jm", "jp", "ke", "kh", "kr",
+		"la", "mh", "mm", "mo", "mt", "mx", "mz", "ni", "np", "pa", "pe", "ph", "pk", "pr", "pt",
+		"py", "sa", "sg", "sv", "th", "tt", "tw", "um", "us", "ve", "vi", "ws", "ye", "za", "zw"}
+	saturdayFirstRegions = []string{"ae", "af", "bh", "dj", "dz", "eg", "iq", "ir", "jo", "kw",
+		"ly", "om", "qa", "sd", "sy"}
+)
+
+// localeFirstWeekday returns the first day of the week in the locale set in the environment.
+func localeFirstWeekday() time.Weekday {
+	_, region := localeLanguage("LC_TIME")
+	for _, r := range sundayFirstRegions {
+		if r == region {
+			return time.Sunday
+		}
+	}
+	for _, r := range saturdayFirstRegions {
+		if r == region {
+			return time.Saturday
+		}
+	}
+	return time.Monday
+}
+
+// localeLanguage returns the lowercase language and region of the locale set by the LC_ALL,
+// `category` or LANG environment variables, like "de" and "ch" for "de_CH.UTF-8".
+func localeLanguage(category string) (language, region string) {
+	locale := ""
+	for _, env := range []string{"LC_ALL", category, "LANG"} {
+		if locale = os.Getenv(env); locale != "" {
+			break
+		}
+	}
+
+	locale = strings.SplitN(locale, ".", 2)[0]
+	parts := strings.FieldsFunc(strings.ToLower(locale), func(r rune) bool { return r == '_' || r == '-' })
+	if len(parts) > 0 {
+		language = parts[0]
+	}
+	if len(parts) > 1 {
+		region = parts[1]
+	}
+	return language, region
+}
+
+// shortName returns the abbreviation of the English month or weekday `name`, translated by
+// lang.Localize. If the abbreviation has no translation, the translated name is cut instead.
+func shortName(name string) string {
+	short := name[:3]
+	if translated := lang.Localize(short); translated != short {
+		return translated
+	}
+
+	runes := []rune(lang.Localize(name))
+	if len(runes) > 3 {
+		runes = runes[:3]
+	}
+	return string(runes)
+}
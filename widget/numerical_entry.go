@@ -1,18 +1,77 @@
 package widget
 
 import (
+	"errors"
+	"math"
+	"math/big"
 	"strconv"
+	"strings"
+	"sync"
+	"unicode"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/driver/mobile"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
+var (
+	errNotInteger = errors.New("not an integer")
+	errOutOfRange = errors.New("number out of range")
+
+	basePrefixes = map[int]string{2: "0b", 8: "0o", 16: "0x"}
+)
+
 // NumericalEntry is an extended entry that only allows numerical input.
-// Only integers are allowed by default. Support for floats can be enabled by setting AllowFloat.
+// Only positive integers are allowed by default. Support for floats, with exponent notation, can be
+// enabled by setting AllowFloat, and support for negative numbers by setting AllowNegative.
+// Numbers are written with the decimal separator of the locale, whichever of '.' or ',' is typed.
+//
+// The value can be stepped with the up and down arrow keys, the mouse wheel while the entry is
+// focused, or the spinner buttons shown if ShowSpinner is set.
 type NumericalEntry struct {
 	widget.Entry
-	AllowFloat bool
+	AllowFloat    bool
+	AllowNegative bool
+
+	// DecimalSeparator and GroupSeparator are the separators used to write the numbers. If not set,
+	// they are detected from the locale set by the LC_ALL, LC_NUMERIC or LANG environment variables.
+	DecimalSeparator, GroupSeparator rune
+	// Grouping separates the thousands with the group separator while typing.
+	Grouping bool
+
+	// Base is the base of the numbers, 2, 8, 10 or 16. Floats are only allowed in base 10, the default.
+	Base int
+	// ShowPrefix writes the numbers of base 2, 8 and 16 with the "0b", "0o" and "0x" prefixes.
+	// Typing a prefix is accepted in all cases.
+	ShowPrefix bool
+	// BitWidth limits integers to 8, 16, 32 or 64 bits, signed if AllowNegative is set. Negative numbers
+	// of base 2, 8 and 16 are written in two's complement, on 64 bits if BitWidth is not set.
+	BitWidth int
+
+	// AllowExpression allows arithmetic expressions like "12*3+4", evaluated when Enter is pressed
+	// or the entry loses the focus. Expressions are only allowed in base 10.
+	AllowExpression bool
+	// Units allows numbers followed by a unit of the system, like "2.5 km", converted to the canonical
+	// unit when evaluated as expressions. The value of the entry is in the canonical unit.
+	Units *NumericalUnits
+
+	// Min and Max are the bounds of the value, that is clamped when the entry loses the focus.
+	// The bounds are enforced only if Max is greater than Min.
+	Min, Max float64
+	// Step is the increment of the value when stepping it, 1 if not set. If the entry does not
+	// hold a number, stepping sets it to 0, or to Min if the value is bounded.
+	Step float64
+	// Precision is the number of decimals kept when the value is stepped or clamped.
+	// If not set, floats are formatted with as many decimals as needed.
+	Precision int
+	// ShowSpinner shows buttons to step the value in the entry. It must be set before the entry is shown.
+	ShowSpinner bool
+
+	focused bool
+	bound   *numberString
 }
 
 // NewNumericalEntry returns an extended entry that only allows numerical input.
@@ -22,18 +81,218 @@ func NewNumericalEntry() *NumericalEntry {
 	return entry
 }
 
+// NewNumericalEntryWithFloat returns a numerical entry allowing floats, bound to the `data` value.
+// The entry is bound when it is shown, and writes the value with the format set at that time.
+func NewNumericalEntryWithFloat(data binding.Float) *NumericalEntry {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.bound = &numberString{float: data}
+	return entry
+}
+
+// NewNumericalEntryWithInt returns a numerical entry allowing integers, bound to the `data` value.
+// The entry is bound when it is shown, and writes the value with the format set at that time.
+func NewNumericalEntryWithInt(data binding.Int) *NumericalEntry {
+	entry := NewNumericalEntry()
+	entry.bound = &numberString{integer: data}
+	return entry
+}
+
+// Bits returns the integer written in the entry as a pattern of BitWidth bits, negative numbers
+// in two's complement, as used by registers.
+func (e *NumericalEntry) Bits() (uint64, error) {
+	i, err := e.parseInteger(e.ungroup(e.Text))
+	if err != nil {
+		return 0, err
+	}
+
+	if i.Sign() < 0 {
+		i.Add(i, e.modulus())
+	}
+	if !i.IsUint64() {
+		return 0, errOutOfRange
+	}
+	return i.Uint64(), nil
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (e *NumericalEntry) CreateRenderer() fyne.WidgetRenderer {
+	if e.ShowSpinner && e.ActionItem == nil {
+		e.ActionItem = newNumericalSpinner(e)
+	}
+	if e.bound == nil {
+		return e.Entry.CreateRenderer()
+	}
+
+	// the data is formatted in the goroutine of the bindings, with a copy of the fields
+	var r fyne.WidgetRenderer
+	e.bound.bind(func() {
+		e.bound.setFormat(e.formatFields())
+		e.Bind(e.bound)
+		r = e.Entry.CreateRenderer()
+	})
+	return r
+}
+
+// Decrement decreases the value by Step.
+func (e *NumericalEntry) Decrement() {
+	e.step(-1)
+}
+
+// FocusGained is called when the entry has been given focus.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) FocusGained() {
+	e.focused = true
+	e.Entry.FocusGained()
+}
+
+// FocusLost is called when the entry has had focus removed. The value is clamped to the bounds.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) FocusLost() {
+	e.focused = false
+	if e.integer() && !e.expression() {
+		if i, err := e.parseInteger(e.ungroup(e.Text)); err == nil {
+			if clamped := e.clampInteger(new(big.Int).Set(i)); clamped.Cmp(i) != 0 {
+				e.SetText(e.formatInteger(clamped))
+			}
+		}
+	} else if v, err := e.parse(e.Text); err == nil {
+		if clamped := e.clamp(v); clamped != v || e.expression() {
+			e.SetValue(clamped)
+		}
+	}
+	e.Entry.FocusLost()
+}
+
+// Increment increases the value by Step.
+func (e *NumericalEntry) Increment() {
+	e.step(1)
+}
+
+// Scrolled steps the value when the mouse wheel is used while the entry is focused.
+//
+// Implements: fyne.Scrollable
+func (e *NumericalEntry) Scrolled(ev *fyne.ScrollEvent) {
+	if !e.focused || e.Disabled() {
+		return
+	}
+
+	if ev.Scrolled.DY > 0 {
+		e.Increment()
+	} else if ev.Scrolled.DY < 0 {
+		e.Decrement()
+	}
+}
+
+// SetBits sets the integer of the entry from a pattern of BitWidth bits, read in two's complement
+// if AllowNegative is set.
+func (e *NumericalEntry) SetBits(bits uint64) {
+	i := new(big.Int).SetUint64(bits)
+	i.And(i, new(big.Int).Sub(e.modulus(), big.NewInt(1)))
+	if e.AllowNegative && i.Bit(e.width()-1) == 1 {
+		i.Sub(i, e.modulus())
+	}
+	e.SetText(e.formatInteger(i))
+}
+
+// SetValue sets the value of the entry, formatted with the precision of the entry.
+func (e *NumericalEntry) SetValue(v float64) {
+	e.SetText(e.format(v))
+}
+
+// TypedKey is called if a non-printable key is pressed. The up and down keys step the value.
+//
+// Implements: fyne.Focusable
+func (e *NumericalEntry) TypedKey(key *fyne.KeyEvent) {
+	switch key.Name {
+	case fyne.KeyUp:
+		e.Increment()
+	case fyne.KeyDown:
+		e.Decrement()
+	case fyne.KeyReturn, fyne.KeyEnter:
+		if e.expression() {
+			if v, err := e.parse(e.Text); err == nil {
+				e.SetValue(e.clamp(v))
+			}
+		}
+		e.Entry.TypedKey(key)
+	case fyne.KeyBackspace, fyne.KeyDelete:
+		// remove the digit next to a group separator rather than the separator
+		if _, group := e.separators(); e.Grouping {
+			runes, col := []rune(e.Text), e.cursor()
+			if key.Name == fyne.KeyBackspace && col > 0 && runes[col-1] == group {
+				e.CursorColumn--
+			} else if key.Name == fyne.KeyDelete && col < len(runes) && runes[col] == group {
+				e.CursorColumn++
+			}
+		}
+		e.Entry.TypedKey(key)
+		e.regroup()
+	default:
+		e.Entry.TypedKey(key)
+	}
+}
+
 // TypedRune is called when this item receives a char event.
 //
 // Implements: fyne.Focusable
 func (e *NumericalEntry) TypedRune(r rune) {
-	if r >= '0' && r <= '9' {
-		e.Entry.TypedRune(r)
+	if e.base() != 10 {
+		e.typedBaseRune(r)
+		return
+	}
+
+	decimal, _ := e.separators()
+	if e.expression() {
+		if r == '.' || r == ',' {
+			r = decimal
+		}
+		if r >= '0' && r <= '9' || r == decimal || r == ' ' || strings.ContainsRune("+-*/()", r) ||
+			unicode.IsLetter(r) && (e.Units != nil || e.AllowFloat) {
+			e.Entry.TypedRune(r)
+		}
 		return
 	}
 
-	if e.AllowFloat && (r == '.' || r == ',') {
-		e.Entry.TypedRune(r)
+	runes, col := []rune(e.Text), e.cursor()
+	before := rune(0)
+	if col > 0 {
+		before = runes[col-1]
+	}
+	exponent := strings.ContainsAny(e.Text, "eE")
+
+	switch {
+	case r >= '0' && r <= '9':
+	case e.AllowFloat && (r == '.' || r == ',' || r == decimal):
+		if strings.ContainsRune(e.Text, decimal) || exponent {
+			return
+		}
+		r = decimal
+	case e.AllowFloat && (r == 'e' || r == 'E'):
+		if exponent || before < '0' || before > '9' {
+			return
+		}
+		r = 'e'
+	case r == '-' || r == '+':
+		if before == 'e' {
+			break // sign of the exponent
+		}
+		if r == '+' || !e.AllowNegative || col > 0 || strings.HasPrefix(e.Text, "-") {
+			return
+		}
+	default:
+		return
 	}
+	if !e.fits(runes, col, r) {
+		return
+	}
+
+	e.Entry.TypedRune(r)
+	e.regroup()
 }
 
 // TypedShortcut handles the registered shortcuts.
@@ -41,29 +300,647 @@ func (e *NumericalEntry) TypedRune(r rune) {
 // Implements: fyne.Shortcutable
 func (e *NumericalEntry) TypedShortcut(shortcut fyne.Shortcut) {
 	paste, ok := shortcut.(*fyne.ShortcutPaste)
-	if !ok {
+	if !ok || e.expression() {
 		e.Entry.TypedShortcut(shortcut)
+		e.regroup()
 		return
 	}
 
-	if e.isNumber(paste.Clipboard.Content()) {
-		e.Entry.TypedShortcut(shortcut)
+	text, ok := e.normalize(paste.Clipboard.Content())
+	if !ok || !e.acceptsPaste(text) {
+		return
 	}
+	e.Entry.TypedShortcut(&fyne.ShortcutPaste{Clipboard: &numberClipboard{content: text}})
+	e.regroup()
+}
+
+// Value returns the number written in the entry, or an error if the text is not a valid number.
+func (e *NumericalEntry) Value() (float64, error) {
+	return e.parse(e.Text)
 }
 
 // Keyboard sets up the right keyboard to use on mobile.
 //
 // Implements: mobile.Keyboardable
 func (e *NumericalEntry) Keyboard() mobile.KeyboardType {
+	if e.base() == 16 {
+		return mobile.DefaultKeyboard
+	}
 	return mobile.NumberKeyboard
 }
 
-func (e *NumericalEntry) isNumber(content string) bool {
+// accepts reports if `text` is a number accepted by the entry.
+func (e *NumericalEntry) accepts(text string) bool {
+	if !e.AllowNegative && strings.HasPrefix(text, "-") {
+		return false
+	}
+	_, err := e.parse(text)
+	return err == nil
+}
+
+// acceptsPaste reports if the text of the entry is a number once `text` is pasted at the cursor,
+// replacing the selection. The selection is before or after the cursor, so if both are possible
+// both results must be numbers.
+func (e *NumericalEntry) acceptsPaste(text string) bool {
+	runes, col := []rune(e.Text), e.cursor()
+	selected := e.SelectedText()
+	n := len([]rune(selected))
+
+	var results []string
+	if col >= n && string(runes[col-n:col]) == selected {
+		results = append(results, string(runes[:col-n])+text+string(runes[col:]))
+	}
+	if n > 0 && col+n <= len(runes) && string(runes[col:col+n]) == selected {
+		results = append(results, string(runes[:col])+text+string(runes[col+n:]))
+	}
+	for _, result := range results {
+		if !e.accepts(result) {
+			return false
+		}
+	}
+	return len(results) > 0
+}
+
+func (e *NumericalEntry) base() int {
+	if _, ok := basePrefixes[e.Base]; ok {
+		return e.Base
+	}
+	return 10
+}
+
+func (e *NumericalEntry) bounded() bool {
+	return e.Max > e.Min
+}
+
+func (e *NumericalEntry) clamp(v float64) float64 {
+	if e.integer() && (e.BitWidth > 0 || e.base() != 10) {
+		i, _ := big.NewFloat(math.Round(v)).Int(nil)
+		v, _ = new(big.Float).SetInt(e.clampInteger(i)).Float64()
+		return v
+	}
+	if !e.bounded() {
+		return v
+	}
+	return math.Max(e.Min, math.Min(e.Max, v))
+}
+
+// clampInteger clamps `i` to the bit width and the bounds with exact arithmetic, as integers of
+// 64 bits do not fit in floats.
+func (e *NumericalEntry) clampInteger(i *big.Int) *big.Int {
+	if e.BitWidth > 0 || e.base() != 10 {
+		min, max := e.integerRange()
+		i = clampBig(i, min, max)
+	}
+	if e.bounded() {
+		if !math.IsInf(e.Min, 0) {
+			min, _ := big.NewFloat(math.Ceil(e.Min)).Int(nil)
+			i = clampBig(i, min, nil)
+		}
+		if !math.IsInf(e.Max, 0) {
+			max, _ := big.NewFloat(math.Floor(e.Max)).Int(nil)
+			i = clampBig(i, nil, max)
+		}
+	}
+	return i
+}
+
+// cursor returns the column of the cursor, within the text.
+func (e *NumericalEntry) cursor() int {
+	if n := len([]rune(e.Text)); e.CursorColumn > n {
+		return n
+	}
+	return e.CursorColumn
+}
+
+func (e *NumericalEntry) format(v float64) string {
+	var text string
+	switch {
+	case e.integer():
+		i, _ := big.NewFloat(math.Round(v)).Int(nil)
+		text = e.formatInteger(i)
+	case e.Precision > 0:
+		text = e.localize(strconv.FormatFloat(v, 'f', e.Precision, 64))
+	default:
+		text = e.localize(strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return e.withUnit(text)
+}
+
+// formatFields returns a copy of the fields of the entry formatting and parsing the numbers, that
+// can be used from the goroutine of the data bindings.
+func (e *NumericalEntry) formatFields() *NumericalEntry {
+	return &NumericalEntry{
+		AllowFloat: e.AllowFloat, AllowNegative: e.AllowNegative,
+		DecimalSeparator: e.DecimalSeparator, GroupSeparator: e.GroupSeparator, Grouping: e.Grouping,
+		Base: e.Base, ShowPrefix: e.ShowPrefix, BitWidth: e.BitWidth,
+		AllowExpression: e.AllowExpression, Units: e.Units, Precision: e.Precision,
+	}
+}
+
+// expression reports if the entry evaluates expressions.
+func (e *NumericalEntry) expression() bool {
+	return (e.AllowExpression || e.Units != nil) && e.base() == 10
+}
+
+// formatInteger writes `i` in the base of the entry, in two's complement if negative and not in base 10.
+func (e *NumericalEntry) formatInteger(i *big.Int) string {
+	base := e.base()
+	if base == 10 {
+		return e.localize(i.String())
+	}
+
+	if i.Sign() < 0 {
+		i = new(big.Int).Add(i, e.modulus())
+	}
+	text := i.Text(base)
+	if e.ShowPrefix {
+		text = basePrefixes[base] + text
+	}
+	return text
+}
+
+// fits reports if inserting `r` at the column `col` of the text keeps the integer within the bit width.
+func (e *NumericalEntry) fits(runes []rune, col int, r rune) bool {
+	if !e.integer() || e.BitWidth <= 0 && e.base() == 10 {
+		return true
+	}
+
+	text := string(runes[:col]) + string(r) + string(runes[col:])
+	_, err := e.parseInteger(e.ungroup(text))
+	return err != errOutOfRange
+}
+
+// integer reports if the entry holds integers.
+func (e *NumericalEntry) integer() bool {
+	return !e.AllowFloat || e.base() != 10
+}
+
+// integerRange returns the minimum and maximum integers allowed by the bit width.
+func (e *NumericalEntry) integerRange() (min, max *big.Int) {
+	modulus := e.modulus()
+	if e.AllowNegative {
+		max = new(big.Int).Rsh(modulus, 1)
+		min = new(big.Int).Neg(max)
+	} else {
+		max, min = modulus, big.NewInt(0)
+	}
+	return min, max.Sub(max, big.NewInt(1))
+}
+
+// localize writes the canonical `number` with the separators of the entry.
+func (e *NumericalEntry) localize(number string) string {
+	decimal, group := e.separators()
+	number = strings.Replace(number, ".", string(decimal), 1)
+	if e.Grouping {
+		number = groupDigits(number, group)
+	}
+	return number
+}
+
+// normalize converts pasted content to a number written with the separators of the entry,
+// and reports if it is a number accepted by the entry.
+func (e *NumericalEntry) normalize(content string) (string, bool) {
+	if base := e.base(); base != 10 {
+		number := strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) || r == '_' {
+				return -1
+			}
+			return r
+		}, content)
+		if _, err := e.parseInteger(number); err != nil {
+			return "", false
+		}
+
+		number = e.trimPrefix(number)
+		if e.ShowPrefix && e.Text == "" {
+			number = basePrefixes[base] + number
+		}
+		return number, true
+	}
+
+	decimal, _ := e.separators()
+	number := normalizePasted(content, decimal)
+	if number == "" || strings.IndexFunc(number, func(r rune) bool {
+		return (r < '0' || r > '9') && !strings.ContainsRune(".eE+-", r)
+	}) >= 0 {
+		return "", false
+	}
+	if !e.AllowNegative && strings.HasPrefix(number, "-") {
+		return "", false
+	}
+
+	var err error
 	if e.AllowFloat {
-		_, err := strconv.ParseFloat(content, 64)
-		return err == nil
+		_, err = strconv.ParseFloat(number, 64)
+	} else {
+		_, err = e.parseInteger(number)
 	}
+	return e.localize(number), err == nil
+}
 
-	_, err := strconv.Atoi(content)
-	return err == nil
+// modulus returns 2 to the power of the bit width.
+func (e *NumericalEntry) modulus() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(e.width()))
+}
+
+func (e *NumericalEntry) parse(text string) (float64, error) {
+	text = e.ungroup(text)
+	if e.expression() {
+		decimal, _ := e.separators()
+		v, err := evaluateExpression(text, decimal, e.Units)
+		if err != nil {
+			return 0, err
+		}
+		if v < 0 && !e.AllowNegative {
+			return 0, errOutOfRange
+		}
+		if e.integer() {
+			v = math.Round(v)
+		}
+		return v, nil
+	}
+	if !e.integer() {
+		decimal, _ := e.separators()
+		return strconv.ParseFloat(strings.Replace(text, string(decimal), ".", 1), 64)
+	}
+
+	i, err := e.parseInteger(text)
+	if err != nil {
+		return 0, err
+	}
+	f, _ := new(big.Float).SetInt(i).Float64()
+	return f, nil
+}
+
+// parseInteger parses an integer written in the base of the entry, within its bit width.
+// Numbers of base 2, 8 and 16 are read in two's complement if negative numbers are allowed.
+func (e *NumericalEntry) parseInteger(text string) (*big.Int, error) {
+	base := e.base()
+	if base != 10 {
+		text = e.trimPrefix(text)
+		if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+			return nil, errNotInteger
+		}
+	}
+	i, ok := new(big.Int).SetString(text, base)
+	if !ok {
+		return nil, errNotInteger
+	}
+
+	if base != 10 {
+		if i.BitLen() > e.width() {
+			return nil, errOutOfRange
+		}
+		if e.AllowNegative && i.Bit(e.width()-1) == 1 {
+			i.Sub(i, e.modulus())
+		}
+		return i, nil
+	}
+	if e.BitWidth > 0 {
+		if min, max := e.integerRange(); i.Cmp(min) < 0 || i.Cmp(max) > 0 {
+			return nil, errOutOfRange
+		}
+	}
+	return i, nil
+}
+
+// regroup separates the thousands of the text if grouping is enabled, keeping the cursor after the same digit.
+func (e *NumericalEntry) regroup() {
+	if !e.Grouping || e.base() != 10 || e.expression() {
+		return
+	}
+	_, group := e.separators()
+
+	digits := 0
+	for _, r := range []rune(e.Text)[:e.cursor()] {
+		if r != group {
+			digits++
+		}
+	}
+	text := groupDigits(strings.Replace(e.Text, string(group), "", -1), group)
+	if text == e.Text {
+		return
+	}
+
+	e.SetText(text)
+	col := 0
+	for i, r := range []rune(text) {
+		if digits == 0 {
+			break
+		}
+		col = i + 1
+		if r != group {
+			digits--
+		}
+	}
+	e.CursorColumn = col
+	e.Refresh()
+}
+
+// separators returns the decimal and group separators of the entry.
+func (e *NumericalEntry) separators() (decimal, group rune) {
+	decimal, group = e.DecimalSeparator, e.GroupSeparator
+	if decimal != 0 && group != 0 {
+		return decimal, group
+	}
+
+	localDecimal, localGroup := localeSeparators()
+	if decimal == 0 {
+		decimal = localDecimal
+	}
+	if group == 0 {
+		group = localGroup
+	}
+	if group == decimal {
+		group = localDecimal
+	}
+	return decimal, group
+}
+
+// trimPrefix removes the prefix of the base of the entry from `text`, if present.
+func (e *NumericalEntry) trimPrefix(text string) string {
+	if prefix := basePrefixes[e.base()]; len(text) >= 2 && strings.ToLower(text[:2]) == prefix {
+		return text[2:]
+	}
+	return text
+}
+
+// typedBaseRune handles a char event in base 2, 8 or 16.
+func (e *NumericalEntry) typedBaseRune(r rune) {
+	runes, col := []rune(e.Text), e.cursor()
+	prefix := basePrefixes[e.base()]
+	if unicode.ToLower(r) == rune(prefix[1]) && col == 1 && runes[0] == '0' &&
+		e.trimPrefix(e.Text) == e.Text {
+		e.Entry.TypedRune(unicode.ToLower(r))
+		return
+	}
+
+	if digitValue(r) >= e.base() || !e.fits(runes, col, r) {
+		return
+	}
+	e.Entry.TypedRune(r)
+}
+
+// ungroup removes the group separators from `text`.
+func (e *NumericalEntry) ungroup(text string) string {
+	if e.base() != 10 {
+		return text
+	}
+	_, group := e.separators()
+	return strings.Replace(text, string(group), "", -1)
+}
+
+// width returns the bit width of the integers, 64 if not set.
+func (e *NumericalEntry) width() int {
+	if e.BitWidth <= 0 {
+		return 64
+	}
+	return e.BitWidth
+}
+
+func (e *NumericalEntry) step(direction float64) {
+	if e.Disabled() {
+		return
+	}
+
+	step := e.Step
+	if step <= 0 {
+		step = 1
+	}
+	if e.integer() {
+		e.stepInteger(direction * step)
+		return
+	}
+
+	v, err := e.parse(e.Text)
+	if err != nil {
+		v = 0
+		if e.bounded() {
+			v = e.Min
+		}
+	} else {
+		v += direction * step
+	}
+
+	if e.AllowFloat && e.Precision > 0 {
+		scale := math.Pow(10, float64(e.Precision))
+		v = math.Round(v*scale) / scale
+	}
+	e.SetValue(e.clamp(v))
+}
+
+// stepInteger adds `delta`, rounded, to the integer of the entry with exact arithmetic.
+func (e *NumericalEntry) stepInteger(delta float64) {
+	i, err := e.parseInteger(e.ungroup(e.Text))
+	if err != nil && e.expression() {
+		var v float64
+		if v, err = e.parse(e.Text); err == nil {
+			i, _ = big.NewFloat(v).Int(nil)
+		}
+	}
+
+	if err != nil {
+		i = big.NewInt(0)
+		if e.bounded() && !math.IsInf(e.Min, 0) {
+			i, _ = big.NewFloat(math.Ceil(e.Min)).Int(nil)
+		}
+	} else {
+		d, _ := big.NewFloat(math.Round(delta)).Int(nil)
+		i.Add(i, d)
+	}
+	e.SetText(e.withUnit(e.formatInteger(e.clampInteger(i))))
+}
+
+// withUnit appends the canonical unit to the number `text`, if the entry has units.
+func (e *NumericalEntry) withUnit(text string) string {
+	if e.Units != nil && e.expression() {
+		text += " " + e.Units.Canonical
+	}
+	return text
+}
+
+// clampBig returns `i` clamped to `min` and `max`, that are ignored if nil.
+func clampBig(i, min, max *big.Int) *big.Int {
+	if min != nil && i.Cmp(min) < 0 {
+		return new(big.Int).Set(min)
+	}
+	if max != nil && i.Cmp(max) > 0 {
+		return new(big.Int).Set(max)
+	}
+	return i
+}
+
+// intValue returns `i` as an int, or errOutOfRange if it does not fit.
+func intValue(i *big.Int) (int, error) {
+	if !i.IsInt64() || int64(int(i.Int64())) != i.Int64() {
+		return 0, errOutOfRange
+	}
+	return int(i.Int64()), nil
+}
+
+// digitValue returns the value of the digit `r` in bases up to 36, or 36 if it is not a digit.
+func digitValue(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0')
+	case r >= 'a' && r <= 'z':
+		return int(r-'a') + 10
+	case r >= 'A' && r <= 'Z':
+		return int(r-'A') + 10
+	}
+	return 36
+}
+
+// numberString adapts a Float or Int binding to the String binding of the entry,
+// using a copy of the format of the entry, as it is used in the goroutine of the data bindings.
+type numberString struct {
+	entryBinding
+	float   binding.Float
+	integer binding.Int
+
+	lock   sync.Mutex
+	format *NumericalEntry
+}
+
+func (s *numberString) AddListener(l binding.DataListener) {
+	s.addListener(s.item(), l)
+}
+
+func (s *numberString) Get() (string, error) {
+	format := s.formatFields()
+	if s.float != nil {
+		v, err := s.float.Get()
+		return format.format(v), err
+	}
+
+	v, err := s.integer.Get()
+	if !format.integer() {
+		return format.format(float64(v)), err
+	}
+	return format.withUnit(format.formatInteger(big.NewInt(int64(v)))), err
+}
+
+func (s *numberString) RemoveListener(l binding.DataListener) {
+	s.removeListener(s.item(), l)
+}
+
+func (s *numberString) Set(text string) error {
+	format := s.formatFields()
+	if s.integer != nil && format.integer() && !format.expression() {
+		i, err := format.parseInteger(format.ungroup(text))
+		if err != nil {
+			return err
+		}
+		v, err := intValue(i)
+		if err != nil {
+			return err
+		}
+		return s.integer.Set(v)
+	}
+
+	v, err := format.parse(text)
+	if err != nil {
+		return err
+	}
+
+	if s.float != nil {
+		return s.float.Set(v)
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return errOutOfRange
+	}
+	i, _ := big.NewFloat(math.Round(v)).Int(nil)
+	iv, err := intValue(i)
+	if err != nil {
+		return err
+	}
+	return s.integer.Set(iv)
+}
+
+func (s *numberString) formatFields() *NumericalEntry {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.format
+}
+
+func (s *numberString) setFormat(format *NumericalEntry) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.format = format
+}
+
+func (s *numberString) item() binding.DataItem {
+	if s.float != nil {
+		return s.float
+	}
+	return s.integer
+}
+
+// numberClipboard holds a pasted number once normalized.
+type numberClipboard struct {
+	content string
+}
+
+func (c *numberClipboard) Content() string {
+	return c.content
+}
+
+func (c *numberClipboard) SetContent(content string) {
+	c.content = content
+}
+
+// numericalSpinner shows an up and a down button, in the square of the entry action item.
+type numericalSpinner struct {
+	widget.BaseWidget
+	entry *NumericalEntry
+}
+
+func newNumericalSpinner(entry *NumericalEntry) *numericalSpinner {
+	s := &numericalSpinner{entry: entry}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+func (s *numericalSpinner) CreateRenderer() fyne.WidgetRenderer {
+	return &numericalSpinnerRenderer{up: widget.NewIcon(theme.MenuDropUpIcon()),
+		down: widget.NewIcon(theme.MenuDropDownIcon())}
+}
+
+func (s *numericalSpinner) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (s *numericalSpinner) Tapped(ev *fyne.PointEvent) {
+	if ev.Position.Y < s.Size().Height/2 {
+		s.entry.Increment()
+	} else {
+		s.entry.Decrement()
+	}
+}
+
+type numericalSpinnerRenderer struct {
+	up, down *widget.Icon
+}
+
+func (r *numericalSpinnerRenderer) Destroy() {
+}
+
+func (r *numericalSpinnerRenderer) Layout(s fyne.Size) {
+	half := fyne.NewSize(s.Width, s.Height/2)
+	r.up.Resize(half)
+	r.down.Move(fyne.NewPos(0, half.Height))
+	r.down.Resize(half)
+}
+
+func (r *numericalSpinnerRenderer) MinSize() fyne.Size {
+	return fyne.NewSquareSize(theme.IconInlineSize())
+}
+
+func (r *numericalSpinnerRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.up, r.down}
+}
+
+func (r *numericalSpinnerRenderer) Refresh() {
+	r.up.SetResource(theme.MenuDropUpIcon())
+	r.down.SetResource(theme.MenuDropDownIcon())
 }
@@ -1,8 +1,13 @@
 package widget
 
 import (
+	"math"
+	"sync"
 	"testing"
+	"time"
 
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +26,7 @@ func TestNumericalnEntry_Int(t *testing.T) {
 func TestNumericalnEntry_Float(t *testing.T) {
 	entry := NewNumericalEntry()
 	entry.AllowFloat = true
+	entry.DecimalSeparator = '.'
 
 	test.Type(entry, "Not a number")
 	assert.Empty(t, entry.Text)
@@ -29,3 +35,356 @@ func TestNumericalnEntry_Float(t *testing.T) {
 	test.Type(entry, number)
 	assert.Equal(t, number, entry.Text)
 }
+
+func TestNumericalEntry_Step(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Min, entry.Max, entry.Step = -2, 10, 4
+
+	entry.Increment()
+	assert.Equal(t, "-2", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, "2", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyUp})
+	assert.Equal(t, "10", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown})
+	assert.Equal(t, "6", entry.Text)
+
+	entry.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, 1)})
+	assert.Equal(t, "6", entry.Text)
+	entry.FocusGained()
+	entry.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -1)})
+	assert.Equal(t, "2", entry.Text)
+}
+
+func TestNumericalEntry_StepLargeInteger(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.SetText("9007199254740993") // 2^53 + 1, not representable as a float64
+	entry.Increment()
+	assert.Equal(t, "9007199254740994", entry.Text)
+	entry.Decrement()
+	entry.Decrement()
+	assert.Equal(t, "9007199254740992", entry.Text)
+
+	entry.Min, entry.Max = 0, 9007199254740992
+	entry.SetText("9007199254740993")
+	entry.FocusLost()
+	assert.Equal(t, "9007199254740992", entry.Text)
+	entry.SetText("9007199254740991")
+	entry.FocusLost()
+	assert.Equal(t, "9007199254740991", entry.Text)
+}
+
+func TestNumericalEntry_StepFloat(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.Step, entry.Precision = 0.1, 2
+	entry.DecimalSeparator = ','
+
+	entry.SetText("0,2")
+	entry.Increment()
+	assert.Equal(t, "0,30", entry.Text)
+}
+
+func TestNumericalEntry_Clamp(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Min, entry.Max = 1, 5
+
+	test.Type(entry, "42")
+	entry.FocusLost()
+	assert.Equal(t, "5", entry.Text)
+
+	entry.Max = 0 // unbounded
+	entry.SetText("42")
+	entry.FocusLost()
+	assert.Equal(t, "42", entry.Text)
+}
+
+func TestNumericalEntry_Spinner(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.ShowSpinner = true
+	w := test.NewWindow(entry)
+	defer w.Close()
+	w.Resize(fyne.NewSize(150, 50))
+
+	spinner := entry.ActionItem.(*numericalSpinner)
+	test.TapAt(spinner, fyne.NewPos(1, 1)) // starts from 0
+	test.TapAt(spinner, fyne.NewPos(1, 1))
+	assert.Equal(t, "1", entry.Text)
+	test.TapAt(spinner, fyne.NewPos(1, spinner.Size().Height-1))
+	assert.Equal(t, "0", entry.Text)
+}
+
+func TestNumericalEntry_Bound(t *testing.T) {
+	f := binding.NewFloat()
+	entry := NewNumericalEntryWithFloat(f)
+	entry.Precision = 1
+	entry.Step = 0.5
+	entry.DecimalSeparator = '.'
+	test.WidgetRenderer(entry)
+	waitForText(t, entry, "0.0")
+
+	entry.Increment()
+	v, _ := f.Get()
+	assert.Equal(t, 0.5, v)
+
+	i := binding.NewInt()
+	_ = i.Set(7)
+	intEntry := NewNumericalEntryWithInt(i)
+	test.WidgetRenderer(intEntry)
+	waitForText(t, intEntry, "7")
+	intEntry.Increment()
+	iv, _ := i.Get()
+	assert.Equal(t, 8, iv)
+}
+
+func TestNumericalEntry_BoundLargeInt(t *testing.T) {
+	i := binding.NewInt()
+	_ = i.Set(1<<62 + 1)
+	entry := NewNumericalEntryWithInt(i)
+	entry.AllowNegative = true
+	test.WidgetRenderer(entry)
+	waitForText(t, entry, "4611686018427387905")
+
+	time.Sleep(150 * time.Millisecond) // the entry ignores the data written just after its own changes
+	_ = i.Set(-1<<63 + 1)
+	waitForText(t, entry, "-9223372036854775807")
+
+	entry.SetText("9223372036854775807")
+	waitForBindings()
+	v, _ := i.Get()
+	assert.Equal(t, int64(9223372036854775807), int64(v))
+
+	assert.Equal(t, errOutOfRange, entry.bound.Set("9223372036854775808"))
+	v, _ = i.Get()
+	assert.Equal(t, int64(9223372036854775807), int64(v))
+}
+
+// waitForText waits for the bound entry to show the `text`, reading it once the listeners of the
+// data bindings, that set it, are called.
+func waitForText(t *testing.T, entry *NumericalEntry, text string) {
+	for i := 0; i < 100; i++ {
+		waitForBindings()
+		if entry.Text == text {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, text, entry.Text)
+}
+
+// waitForBindings waits until the listeners of the data bindings queued so far have been called.
+func waitForBindings() {
+	done := make(chan struct{})
+	var once sync.Once
+	binding.NewBool().AddListener(binding.NewDataListener(func() {
+		once.Do(func() { close(done) })
+	}))
+	<-done
+}
+
+func TestNumericalEntry_Negative(t *testing.T) {
+	entry := NewNumericalEntry()
+	test.Type(entry, "-12")
+	assert.Equal(t, "12", entry.Text)
+
+	entry.AllowNegative = true
+	entry.SetText("")
+	test.Type(entry, "-1-2")
+	assert.Equal(t, "-12", entry.Text)
+	v, err := entry.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, -12.0, v)
+}
+
+func TestNumericalEntry_Exponent(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.DecimalSeparator = '.'
+
+	test.Type(entry, "e1,5e-3E2")
+	assert.Equal(t, "1.5e-32", entry.Text)
+	v, err := entry.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5e-32, v)
+}
+
+func TestNumericalEntry_Locale(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.DecimalSeparator, entry.GroupSeparator = ',', '.'
+	entry.Grouping = true
+
+	test.Type(entry, "1234567.8")
+	assert.Equal(t, "1.234.567,8", entry.Text)
+	v, _ := entry.Value()
+	assert.Equal(t, 1234567.8, v)
+
+	entry.CursorColumn = 2 // after the first separator
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyBackspace})
+	assert.Equal(t, "234.567,8", entry.Text)
+	assert.Equal(t, 0, entry.CursorColumn)
+
+	entry.SetValue(-9876.5)
+	assert.Equal(t, "-9.876,5", entry.Text)
+}
+
+func TestNumericalEntry_Paste(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.DecimalSeparator, entry.GroupSeparator = '.', ','
+	clipboard := test.NewClipboard()
+	paste := &fyne.ShortcutPaste{Clipboard: clipboard}
+
+	for content, expected := range map[string]string{
+		"1 234,5":   "1234.5",
+		"1.234,5":   "1234.5",
+		"1,234,567": "1234567",
+		"2,5":       "2.5",
+		"1e3":       "1e3",
+		"-4":        "",
+		"0x10":      "",
+		"Inf":       "",
+	} {
+		entry.SetText("")
+		clipboard.SetContent(content)
+		entry.TypedShortcut(paste)
+		assert.Equal(t, expected, entry.Text, content)
+	}
+}
+
+func TestNumericalEntry_PasteInText(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowFloat = true
+	entry.AllowNegative = true
+	entry.DecimalSeparator, entry.GroupSeparator = '.', ','
+	clipboard := test.NewClipboard()
+	paste := &fyne.ShortcutPaste{Clipboard: clipboard}
+
+	entry.SetText("12")
+	entry.CursorColumn = 2
+	clipboard.SetContent("-3")
+	entry.TypedShortcut(paste)
+	assert.Equal(t, "12", entry.Text) // a sign in the middle
+
+	entry.SetText("1.5")
+	entry.CursorColumn = 3
+	clipboard.SetContent("2.5")
+	entry.TypedShortcut(paste)
+	assert.Equal(t, "1.5", entry.Text) // a second decimal separator
+	clipboard.SetContent("25")
+	entry.TypedShortcut(paste)
+	assert.Equal(t, "1.525", entry.Text)
+
+	entry.TypedShortcut(&fyne.ShortcutSelectAll{})
+	clipboard.SetContent("-3")
+	entry.TypedShortcut(paste)
+	assert.Equal(t, "-3", entry.Text) // the selection is replaced
+}
+
+func TestGroupDigits(t *testing.T) {
+	assert.Equal(t, "1", groupDigits("1", ','))
+	assert.Equal(t, "123", groupDigits("123", ','))
+	assert.Equal(t, "1,234", groupDigits("1234", ','))
+	assert.Equal(t, "-123,456.7890", groupDigits("-123456.7890", ','))
+	assert.Equal(t, "1 000e10", groupDigits("1000e10", ' '))
+}
+
+func TestNumericalEntry_Hex(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Base = 16
+
+	test.Type(entry, "0xg1F-")
+	assert.Equal(t, "0x1F", entry.Text)
+	v, err := entry.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 31.0, v)
+
+	entry.ShowPrefix = true
+	entry.Increment()
+	assert.Equal(t, "0x20", entry.Text)
+}
+
+func TestNumericalEntry_Binary(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Base = 2
+	entry.BitWidth = 4
+
+	test.Type(entry, "0b12101")
+	assert.Equal(t, "0b1101", entry.Text) // the last digit does not fit
+	bits, err := entry.Bits()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(13), bits)
+
+	entry.Increment()
+	entry.Increment()
+	entry.Increment()
+	assert.Equal(t, "1111", entry.Text)
+}
+
+func TestNumericalEntry_TwosComplement(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Base = 16
+	entry.BitWidth = 8
+	entry.AllowNegative = true
+
+	entry.SetValue(-1)
+	assert.Equal(t, "ff", entry.Text)
+	v, _ := entry.Value()
+	assert.Equal(t, -1.0, v)
+	bits, _ := entry.Bits()
+	assert.Equal(t, uint64(0xff), bits)
+
+	entry.SetBits(0x180)
+	assert.Equal(t, "80", entry.Text)
+	v, _ = entry.Value()
+	assert.Equal(t, -128.0, v)
+	entry.Decrement()
+	assert.Equal(t, "80", entry.Text)
+
+	entry.Base = 10
+	entry.SetBits(0xfe)
+	assert.Equal(t, "-2", entry.Text)
+	bits, _ = entry.Bits()
+	assert.Equal(t, uint64(0xfe), bits)
+}
+
+func TestNumericalEntry_BitWidth(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.BitWidth = 8
+
+	test.Type(entry, "2560")
+	assert.Equal(t, "250", entry.Text)
+
+	entry.Base = 16
+	entry.BitWidth = 0
+	entry.SetText("ffffffffffffffff")
+	bits, err := entry.Bits()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), bits)
+}
+
+func TestNumericalEntry_StepBitWidth(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.Base = 16
+
+	entry.SetText("fffffffffffffff0")
+	entry.Increment()
+	assert.Equal(t, "fffffffffffffff1", entry.Text)
+	entry.SetText("ffffffffffffffff")
+	entry.Increment()
+	assert.Equal(t, "ffffffffffffffff", entry.Text)
+	bits, err := entry.Bits()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), bits)
+
+	entry.AllowNegative = true
+	entry.SetText("7fffffffffffffff")
+	entry.Increment()
+	assert.Equal(t, "7fffffffffffffff", entry.Text)
+	entry.SetText("8000000000000000")
+	entry.Decrement()
+	assert.Equal(t, "8000000000000000", entry.Text)
+	entry.Increment()
+	assert.Equal(t, "8000000000000001", entry.Text)
+}
@@ -0,0 +1,235 @@
+package widget
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// maxExpressionDepth limits the nesting of parentheses in the expressions of a NumericalEntry.
+const maxExpressionDepth = 32
+
+var (
+	errInvalidExpression = errors.New("invalid expression")
+	errUnknownUnit       = errors.New("unknown unit")
+)
+
+// NumericalUnits is a system of units of a quantity, such as lengths, used by a NumericalEntry
+// to read numbers with a unit and convert them to the canonical unit.
+type NumericalUnits struct {
+	// Canonical is the symbol of the unit of the values, that must be in Factors.
+	Canonical string
+	// Factors are the values of the units in the canonical unit, by symbol.
+	Factors map[string]float64
+}
+
+var (
+	// LengthUnits are the metric and imperial units of length, in meters.
+	LengthUnits = &NumericalUnits{Canonical: "m", Factors: map[string]float64{
+		"nm": 1e-9, "µm": 1e-6, "um": 1e-6, "mm": 1e-3, "cm": 1e-2, "m": 1, "km": 1e3,
+		"in": 0.0254, "ft": 0.3048, "yd": 0.9144, "mi": 1609.344,
+	}}
+	// MassUnits are the metric and imperial units of mass, in kilograms.
+	MassUnits = &NumericalUnits{Canonical: "kg", Factors: map[string]float64{
+		"mg": 1e-6, "g": 1e-3, "kg": 1, "t": 1e3, "oz": 0.028349523125, "lb": 0.45359237,
+	}}
+	// TimeUnits are the units of time, in seconds.
+	TimeUnits = &NumericalUnits{Canonical: "s", Factors: map[string]float64{
+		"ns": 1e-9, "µs": 1e-6, "us": 1e-6, "ms": 1e-3, "s": 1, "min": 60, "h": 3600, "d": 86400,
+	}}
+	// ByteUnits are the decimal and binary units of data sizes, in bytes.
+	ByteUnits = &NumericalUnits{Canonical: "B", Factors: map[string]float64{
+		"B": 1, "kB": 1e3, "MB": 1e6, "GB": 1e9, "TB": 1e12,
+		"KiB": 1 << 10, "MiB": 1 << 20, "GiB": 1 << 30, "TiB": 1 << 40,
+	}}
+)
+
+// factor returns the value of the unit `symbol` in the canonical unit.
+// The case of the symbol is ignored if it does not match exactly.
+func (u *NumericalUnits) factor(symbol string) (float64, bool) {
+	if f, ok := u.Factors[symbol]; ok {
+		return f, true
+	}
+	for s, f := range u.Factors {
+		if strings.EqualFold(s, symbol) {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// expressionParser evaluates arithmetic expressions with the grammar:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = factor { ("*" | "/") factor }
+//	factor     = ("+" | "-") factor | (number | "(" expression ")") [unit]
+type expressionParser struct {
+	text    []rune
+	pos     int
+	depth   int
+	decimal rune
+	units   *NumericalUnits
+}
+
+// evaluateExpression returns the value of the arithmetic expression `text`, whose numbers are
+// written with the `decimal` separator and can be followed by one of the `units`, that can be nil.
+func evaluateExpression(text string, decimal rune, units *NumericalUnits) (float64, error) {
+	p := &expressionParser{text: []rune(text), decimal: decimal, units: units}
+	v, err := p.expression()
+	if err != nil {
+		return 0, err
+	}
+	if p.skipSpaces(); p.pos < len(p.text) {
+		return 0, errInvalidExpression
+	}
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, errInvalidExpression
+	}
+	return v, nil
+}
+
+func (p *expressionParser) expression() (float64, error) {
+	v, err := p.term()
+	for err == nil {
+		var op rune
+		if op = p.next(); op != '+' && op != '-' {
+			return v, nil
+		}
+		p.pos++
+
+		var t float64
+		if t, err = p.term(); op == '+' {
+			v += t
+		} else {
+			v -= t
+		}
+	}
+	return 0, err
+}
+
+func (p *expressionParser) factor() (float64, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return 0, errInvalidExpression
+	}
+
+	switch p.next() {
+	case '+':
+		p.pos++
+		return p.factor()
+	case '-':
+		p.pos++
+		v, err := p.factor()
+		return -v, err
+	}
+
+	var v float64
+	var err error
+	if p.next() == '(' {
+		p.pos++
+		if v, err = p.expression(); err != nil {
+			return 0, err
+		}
+		if p.next() != ')' {
+			return 0, errInvalidExpression
+		}
+		p.pos++
+	} else if v, err = p.number(); err != nil {
+		return 0, err
+	}
+
+	f, err := p.unit()
+	return v * f, err
+}
+
+// next returns the next rune that is not a space, or 0 at the end of the text.
+func (p *expressionParser) next() rune {
+	p.skipSpaces()
+	if p.pos >= len(p.text) {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *expressionParser) number() (float64, error) {
+	p.skipSpaces()
+	start := p.pos
+	digits := func() {
+		for p.pos < len(p.text) && p.text[p.pos] >= '0' && p.text[p.pos] <= '9' {
+			p.pos++
+		}
+	}
+
+	digits()
+	if p.pos < len(p.text) && p.text[p.pos] == p.decimal {
+		p.pos++
+		digits()
+	}
+	if p.pos < len(p.text) && (p.text[p.pos] == 'e' || p.text[p.pos] == 'E') {
+		// an exponent, unless the "e" starts a unit
+		exp := p.pos + 1
+		if exp < len(p.text) && (p.text[exp] == '+' || p.text[exp] == '-') {
+			exp++
+		}
+		if exp < len(p.text) && p.text[exp] >= '0' && p.text[exp] <= '9' {
+			p.pos = exp
+			digits()
+		}
+	}
+
+	number := strings.Replace(string(p.text[start:p.pos]), string(p.decimal), ".", 1)
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, errInvalidExpression
+	}
+	return v, nil
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.text) && unicode.IsSpace(p.text[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) term() (float64, error) {
+	v, err := p.factor()
+	for err == nil {
+		var op rune
+		if op = p.next(); op != '*' && op != '/' {
+			return v, nil
+		}
+		p.pos++
+
+		var f float64
+		if f, err = p.factor(); op == '*' {
+			v *= f
+		} else if f == 0 {
+			err = errInvalidExpression
+		} else {
+			v /= f
+		}
+	}
+	return 0, err
+}
+
+// unit reads the unit following a value, if any, and returns its factor.
+func (p *expressionParser) unit() (float64, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.text) && unicode.IsLetter(p.text[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return 1, nil
+	}
+
+	if p.units != nil {
+		if f, ok := p.units.factor(string(p.text[start:p.pos])); ok {
+			return f, nil
+		}
+	}
+	return 0, errUnknownUnit
+}
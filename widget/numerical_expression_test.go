@@ -0,0 +1,93 @@
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateExpression(t *testing.T) {
+	for text, expected := range map[string]float64{
+		"12*3+4":         40,
+		"12 * (3 + 4)":   84,
+		"-2 - -3":        1,
+		"7 / 2":          3.5,
+		"1.5e3":          1500,
+		"2.5 km":         2500,
+		"1km + 200m":     1200,
+		"(1 + 1) ft":     0.6096,
+		"3 KM * 2":       6000, // case insensitive
+		"10 / 4 * 2 - 1": 4,
+	} {
+		v, err := evaluateExpression(text, '.', LengthUnits)
+		assert.NoError(t, err, text)
+		assert.InDelta(t, expected, v, 1e-9, text)
+	}
+
+	for _, text := range []string{"", "1 +", "2 * (3", "1 / 0", "2 parsecs", "1..2", "((((((((((((((((((((((((((((((((((1))))))))))))))))))))))))))))))))))"} {
+		_, err := evaluateExpression(text, '.', LengthUnits)
+		assert.Error(t, err, text)
+	}
+
+	v, err := evaluateExpression("1,5 * 2", ',', nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, v)
+	_, err = evaluateExpression("1 m", '.', nil)
+	assert.Equal(t, errUnknownUnit, err)
+}
+
+func TestNumericalEntry_Expression(t *testing.T) {
+	entry := NewNumericalEntry()
+	entry.AllowExpression = true
+	submitted := ""
+	entry.OnSubmitted = func(s string) {
+		submitted = s
+	}
+
+	test.Type(entry, "12*3+4x")
+	assert.Equal(t, "12*3+4", entry.Text)
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	assert.Equal(t, "40", entry.Text)
+	assert.Equal(t, "40", submitted)
+
+	entry.SetText("7/2")
+	entry.FocusLost()
+	assert.Equal(t, "4", entry.Text) // integers only
+
+	entry.SetText("2-5")
+	entry.FocusLost()
+	assert.Equal(t, "2-5", entry.Text) // negative numbers not allowed
+	_, err := entry.Value()
+	assert.Error(t, err)
+}
+
+func TestNumericalEntry_Units(t *testing.T) {
+	data := binding.NewFloat()
+	entry := NewNumericalEntryWithFloat(data)
+	entry.DecimalSeparator = '.'
+	entry.Units = ByteUnits
+	test.WidgetRenderer(entry)
+	waitForText(t, entry, "0 B")
+
+	entry.SetText("")
+	waitForBindings()
+	for _, r := range "1.5 KiB" {
+		entry.TypedRune(r)
+		waitForBindings() // the data written is sent back to the entry
+	}
+	v, err := entry.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 1536.0, v)
+	f, _ := data.Get()
+	assert.Equal(t, 1536.0, f)
+
+	entry.FocusLost()
+	waitForBindings()
+	assert.Equal(t, "1536 B", entry.Text)
+	entry.Increment()
+	waitForBindings()
+	assert.Equal(t, "1537 B", entry.Text)
+}
@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"strings"
+)
+
+// commaDecimalLanguages are the languages using a comma as decimal separator, with their group separator.
+var commaDecimalLanguages = map[string]rune{
+	"bg": ' ', "ca": '.', "cs": ' ', "da": '.', "de": '.', "el": '.', "es": '.',
+	"et": ' ', "fi": ' ', "fr": ' ', "hr": '.', "hu": ' ', "id": '.', "it": '.',
+	"lt": ' ', "lv": ' ', "nb": ' ', "nl": '.', "no": ' ', "pl": ' ',
+	"pt": '.', "ro": '.', "ru": ' ', "sk": ' ', "sl": '.', "sr": '.', "sv": ' ',
+	"tr": '.', "uk": ' ', "vi": '.',
+}
+
+// pastedGroupSeparators are the characters removed from pasted numbers, as used to group digits.
+const pastedGroupSeparators = " _'\u00a0\u202f\u2019"
+
+// localeSeparators returns the decimal and group separators of the locale set in the environment.
+func localeSeparators() (decimal, group rune) {
+	language, region := localeLanguage("LC_NUMERIC")
+	if region == "ch" && (language == "de" || language == "it" || language == "fr") {
+		return '.', '\u2019'
+	}
+	if group, ok := commaDecimalLanguages[language]; ok {
+		return ',', group
+	}
+	return '.', ','
+}
+
+// groupDigits separates the thousands of the integer part of the canonical `number`, that can
+// have a sign, a decimal point and an exponent.
+func groupDigits(number string, group rune) string {
+	start := 0
+	if start < len(number) && (number[0] == '-' || number[0] == '+') {
+		start = 1
+	}
+	end := start
+	for end < len(number) && number[end] >= '0' && number[end] <= '9' {
+		end++
+	}
+
+	digits := number[start:end]
+	var b strings.Builder
+	b.WriteString(number[:start])
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteRune(group)
+		}
+		b.WriteRune(d)
+	}
+	b.WriteString(number[end:])
+	return b.String()
+}
+
+// normalizePasted converts a pasted number, written with any usual separators, to the canonical
+// notation of `strconv`. The `decimal` separator of the locale is used to resolve ambiguities.
+func normalizePasted(content string, decimal rune) string {
+	content = strings.TrimSpace(content)
+	content = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(pastedGroupSeparators, r) {
+			return -1
+		}
+		return r
+	}, content)
+
+	dots, commas := strings.Count(content, "."), strings.Count(content, ",")
+	switch {
+	case dots > 0 && commas > 0:
+		if strings.LastIndexByte(content, '.') > strings.LastIndexByte(content, ',') {
+			return strings.Replace(content, ",", "", -1)
+		}
+		return strings.Replace(strings.Replace(content, ".", "", -1), ",", ".", 1)
+	case dots > 0:
+		return resolveSeparator(content, '.', dots, decimal)
+	case commas > 0:
+		return resolveSeparator(content, ',', commas, decimal)
+	}
+	return content
+}
+
+// resolveSeparator decides if the `count` occurrences of `sep`, the only separator of `content`,
+// are group or decimal separators.
+func resolveSeparator(content string, sep rune, count int, decimal rune) string {
+	grouped := true
+	for _, part := range strings.Split(content, string(sep))[1:] {
+		digits := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if digits < 0 {
+			digits = len(part)
+		}
+		grouped = grouped && digits == 3
+	}
+
+	if count == 1 && (sep == decimal || !grouped) {
+		return strings.Replace(content, string(sep), ".", 1)
+	}
+	if grouped {
+		return strings.Replace(content, string(sep), "", -1)
+	}
+	return content // invalid, rejected by the parsing
+}
@@ -0,0 +1,401 @@
+package widget
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
+)
+
+const (
+	// schedulerMonthEvents is the number of events shown in a day of the month view.
+	schedulerMonthEvents = 3
+	// schedulerSnapMinutes is the default precision of the times of the dragged events.
+	schedulerSnapMinutes = 15
+)
+
+// SchedulerView is the period shown by a Scheduler.
+type SchedulerView int
+
+const (
+	// SchedulerMonth shows the days of a month, with their first events.
+	SchedulerMonth SchedulerView = iota
+	// SchedulerWeek shows the days of a week, with the events laid out by time.
+	SchedulerWeek
+	// SchedulerDay shows a day, with the events laid out by time.
+	SchedulerDay
+)
+
+// Event is an event shown by a Scheduler, from Start to End, excluded.
+type Event struct {
+	Title      string
+	Start, End time.Time
+	// AllDay events are shown apart from the timed events, on each day from Start to End.
+	AllDay bool
+	// Importance sets the color of the event, the primary color by default.
+	Importance widget.Importance
+	// Data can hold any value of the application, such as the identifier of the event.
+	Data interface{}
+}
+
+// overlaps reports if the event overlaps the range from `start`, included, to `end`, excluded.
+// Events without duration overlap the range if they start within it.
+func (e *Event) overlaps(start, end time.Time) bool {
+	if !e.End.After(e.Start) {
+		return !e.Start.Before(start) && e.Start.Before(end)
+	}
+	return e.Start.Before(end) && e.End.After(start)
+}
+
+// EventSource provides the events shown by a Scheduler.
+type EventSource interface {
+	// Events returns the events overlapping the range from `start`, included, to `end`, excluded.
+	Events(start, end time.Time) []*Event
+}
+
+// EventList is an EventSource holding the events in memory.
+type EventList []*Event
+
+// Events returns the events of the list overlapping the range from `start` to `end`.
+//
+// Implements: EventSource
+func (l EventList) Events(start, end time.Time) []*Event {
+	var events []*Event
+	for _, e := range l {
+		if e.overlaps(start, end) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Scheduler shows the events of an EventSource by month, week or day. In the week and day views,
+// the timed events are laid out by time, side by side when they overlap, and they can be moved or
+// resized by dragging them if OnEventMoved or OnEventResized are set.
+type Scheduler struct {
+	widget.BaseWidget
+
+	// Source provides the events shown.
+	Source EventSource
+	// View is the period shown, a month by default.
+	View SchedulerView
+	// FirstWeekday is the first day of the weeks, set by NewScheduler from the locale.
+	FirstWeekday time.Weekday
+	// StartHour and EndHour are the hours shown in the week and day views, the whole day if EndHour is not set.
+	StartHour, EndHour int
+	// SnapMinutes is the precision of the times of the dragged events, 15 minutes if not set.
+	SnapMinutes int
+
+	// OnEventTapped is called when an event is tapped.
+	OnEventTapped func(*Event)
+	// OnEventMoved is called when an event is dragged to a new time, in the week and day views.
+	// The application should update the event, the scheduler is refreshed after the call.
+	OnEventMoved func(event *Event, start, end time.Time)
+	// OnEventResized is called when the end of an event is dragged, in the week and day views.
+	// The application should update the event, the scheduler is refreshed after the call.
+	OnEventResized func(event *Event, end time.Time)
+
+	current time.Time
+	grid    *timeGridLayout
+
+	previous, next *widget.Button
+	title          *widget.Label
+	views          *widget.Select
+	body           *fyne.Container
+}
+
+// NewScheduler returns a scheduler showing the events of the `source` for the month of `t`.
+func NewScheduler(t time.Time, source EventSource) *Scheduler {
+	s := &Scheduler{current: t, Source: source, FirstWeekday: localeFirstWeekday()}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// CreateRenderer returns a new WidgetRenderer for this widget.
+// This should not be called by regular code, it is used internally to render a widget.
+func (s *Scheduler) CreateRenderer() fyne.WidgetRenderer {
+	s.previous = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		s.navigate(-1)
+	})
+	s.next = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		s.navigate(1)
+	})
+	s.title = widget.NewLabel("")
+	s.title.TextStyle.Bold = true
+
+	s.views = widget.NewSelect([]string{lang.Localize("Month"), lang.Localize("Week"), lang.Localize("Day")}, func(string) {
+		if v := SchedulerView(s.views.SelectedIndex()); v != s.View {
+			s.SetView(v)
+		}
+	})
+	s.views.SetSelectedIndex(int(s.View))
+
+	s.body = container.NewStack()
+	s.refreshBody()
+
+	top := container.NewBorder(nil, nil, nil, s.views, newNavigationBar(s.previous, s.next, s.title))
+	return widget.NewSimpleRenderer(container.NewBorder(top, nil, nil, nil, s.body))
+}
+
+// Date returns the date of the period shown.
+func (s *Scheduler) Date() time.Time {
+	return s.current
+}
+
+// Refresh reloads the events from the source and redraws the scheduler.
+//
+// Implements: fyne.Widget
+func (s *Scheduler) Refresh() {
+	s.refreshBody()
+	s.BaseWidget.Refresh()
+}
+
+// SetDate shows the period of the view containing the date `t`.
+func (s *Scheduler) SetDate(t time.Time) {
+	s.current = t
+	s.refreshBody()
+}
+
+// SetView shows the period of the view `v` containing the date of the scheduler.
+func (s *Scheduler) SetView(v SchedulerView) {
+	s.View = v
+	if s.views != nil {
+		s.views.SetSelectedIndex(int(v))
+	}
+	s.refreshBody()
+}
+
+// allDayEvents returns the column of the heading and the all-day events of the day `d`.
+func (s *Scheduler) allDayEvents(d time.Time, events []*Event) fyne.CanvasObject {
+	heading := widget.NewLabel(shortName(d.Weekday().String()) + " " + strconv.Itoa(d.Day()))
+	heading.Alignment = fyne.TextAlignCenter
+	if sameDay(d, time.Now()) {
+		heading.TextStyle.Bold = true
+	}
+
+	items := []fyne.CanvasObject{heading}
+	for _, e := range eventsOn(events, d) {
+		if e.AllDay {
+			items = append(items, newSchedulerEvent(s, e, e.Title))
+		}
+	}
+	return container.NewVBox(items...)
+}
+
+// hours returns the first and last hours shown in the week and day views.
+func (s *Scheduler) hours() (start, end int) {
+	start, end = s.StartHour, s.EndHour
+	if start < 0 || start > 23 {
+		start = 0
+	}
+	if end <= start || end > 24 {
+		end = 24
+	}
+	return start, end
+}
+
+// monthDay returns the cell of the day `d` in the month view, with its first events.
+func (s *Scheduler) monthDay(d time.Time, events []*Event) fyne.CanvasObject {
+	number := widget.NewButton(strconv.Itoa(d.Day()), func() {
+		s.current = d
+		s.SetView(SchedulerDay)
+	})
+	number.Importance = widget.LowImportance
+	if sameDay(d, time.Now()) {
+		number.Importance = widget.HighImportance
+	}
+
+	items := []fyne.CanvasObject{number}
+	for i, e := range events {
+		if i == schedulerMonthEvents {
+			more := widget.NewLabel("+" + strconv.Itoa(len(events)-i))
+			more.Importance = widget.LowImportance
+			items = append(items, more)
+			break
+		}
+
+		text := e.Title
+		if !e.AllDay {
+			text = e.Start.In(d.Location()).Format("15:04") + " " + text
+		}
+		items = append(items, newSchedulerEvent(s, e, text))
+	}
+	return container.NewVBox(items...)
+}
+
+// monthGrid returns the days of the month starting at `start`, laid out like a Calendar.
+func (s *Scheduler) monthGrid(start time.Time, events []*Event) fyne.CanvasObject {
+	objects := weekdayHeadings(s.FirstWeekday)
+	offset := (int(start.Weekday()) - int(s.FirstWeekday) + daysPerWeek) % daysPerWeek
+	for i := 0; i < offset; i++ {
+		objects = append(objects, layout.NewSpacer())
+	}
+	for d := start; d.Month() == start.Month(); d = d.AddDate(0, 0, 1) {
+		objects = append(objects, s.monthDay(d, eventsOn(events, d)))
+	}
+
+	return container.New(newCalendarLayout(daysPerWeek), objects...)
+}
+
+// navigate shows the next period of the view if `direction` is positive, or the previous one.
+func (s *Scheduler) navigate(direction int) {
+	switch s.View {
+	case SchedulerWeek:
+		s.current = s.current.AddDate(0, 0, daysPerWeek*direction)
+	case SchedulerDay:
+		s.current = s.current.AddDate(0, 0, direction)
+	default:
+		s.current = addMonths(s.current, direction)
+	}
+	s.refreshBody()
+}
+
+// period returns the first day of the period shown, and the day after it.
+func (s *Scheduler) period() (start, end time.Time) {
+	day := time.Date(s.current.Year(), s.current.Month(), s.current.Day(), 0, 0, 0, 0, s.current.Location())
+	switch s.View {
+	case SchedulerWeek:
+		offset := (int(day.Weekday()) - int(s.FirstWeekday) + daysPerWeek) % daysPerWeek
+		start = day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, daysPerWeek)
+	case SchedulerDay:
+		return day, day.AddDate(0, 0, 1)
+	}
+
+	start = day.AddDate(0, 0, 1-day.Day())
+	return start, start.AddDate(0, 1, 0)
+}
+
+// refreshBody shows the events of the period, once the scheduler is rendered.
+func (s *Scheduler) refreshBody() {
+	if s.body == nil {
+		return
+	}
+
+	start, end := s.period()
+	var events []*Event
+	if s.Source != nil {
+		events = s.Source.Events(start, end)
+	}
+
+	s.grid = nil
+	switch s.View {
+	case SchedulerWeek:
+		s.body.Objects = []fyne.CanvasObject{s.timeGrid(start, daysPerWeek, events)}
+	case SchedulerDay:
+		s.body.Objects = []fyne.CanvasObject{s.timeGrid(start, 1, events)}
+	default:
+		s.body.Objects = []fyne.CanvasObject{s.monthGrid(start, events)}
+	}
+	s.title.SetText(s.titleText(start, end))
+	s.body.Refresh()
+}
+
+// snap returns the precision of the times of the dragged events.
+func (s *Scheduler) snap() time.Duration {
+	if s.SnapMinutes <= 0 {
+		return schedulerSnapMinutes * time.Minute
+	}
+	return time.Duration(s.SnapMinutes) * time.Minute
+}
+
+// timeGrid returns the `days` starting at `start`, with the all-day events at the top and the
+// timed events laid out by time below.
+func (s *Scheduler) timeGrid(start time.Time, days int, events []*Event) fyne.CanvasObject {
+	startHour, endHour := s.hours()
+	s.grid = newTimeGridLayout(days, endHour-startHour)
+
+	objects := []fyne.CanvasObject{}
+	for h := startHour; h < endHour; h++ {
+		label := canvas.NewText(strconv.Itoa(h)+":00", theme.ForegroundColor())
+		label.TextSize = theme.CaptionTextSize()
+		line := canvas.NewLine(theme.SeparatorColor())
+		s.grid.labels = append(s.grid.labels, label)
+		s.grid.lines = append(s.grid.lines, line)
+		objects = append(objects, line, label)
+	}
+
+	headings := make([]fyne.CanvasObject, days)
+	for i := range headings {
+		d := start.AddDate(0, 0, i)
+		headings[i] = s.allDayEvents(d, events)
+
+		visibleStart := time.Date(d.Year(), d.Month(), d.Day(), startHour, 0, 0, 0, d.Location())
+		visibleEnd := time.Date(d.Year(), d.Month(), d.Day(), endHour, 0, 0, 0, d.Location())
+		var slots []*schedulerEvent
+		for _, e := range events {
+			if e.AllDay || !e.overlaps(visibleStart, visibleEnd) {
+				continue
+			}
+
+			slot := newSchedulerEvent(s, e, e.Title)
+			slot.day, slot.timed = i, true
+			slot.top, slot.bottom = timeSpan(e, visibleStart, visibleEnd)
+			slots = append(slots, slot)
+			objects = append(objects, slot)
+		}
+		arrangeColumns(slots)
+		s.grid.events = append(s.grid.events, slots...)
+	}
+
+	gutter := canvas.NewRectangle(nil)
+	gutter.SetMinSize(fyne.NewSize(s.grid.gutter, 0))
+	top := container.NewBorder(nil, nil, gutter, nil, container.NewGridWithColumns(days, headings...))
+	return container.NewBorder(top, nil, nil, nil, container.NewVScroll(container.New(s.grid, objects...)))
+}
+
+// titleText returns the title of the period from `start` to `end`, excluded.
+func (s *Scheduler) titleText(start, end time.Time) string {
+	switch s.View {
+	case SchedulerWeek:
+		last := end.AddDate(0, 0, -1)
+		return strconv.Itoa(start.Day()) + " " + shortName(start.Month().String()) + " - " +
+			strconv.Itoa(last.Day()) + " " + shortName(last.Month().String()) + " " + strconv.Itoa(last.Year())
+	case SchedulerDay:
+		return lang.Localize(start.Weekday().String()) + " " + strconv.Itoa(start.Day()) + " " +
+			lang.Localize(start.Month().String()) + " " + strconv.Itoa(start.Year())
+	}
+	return lang.Localize(start.Month().String()) + " " + strconv.Itoa(start.Year())
+}
+
+// eventsOn returns the `events` overlapping the day `d`, the all-day events first, then by start.
+func eventsOn(events []*Event, d time.Time) []*Event {
+	var day []*Event
+	for _, e := range events {
+		if e.overlaps(d, d.AddDate(0, 0, 1)) {
+			day = append(day, e)
+		}
+	}
+
+	sort.SliceStable(day, func(i, j int) bool {
+		if day[i].AllDay != day[j].AllDay {
+			return day[i].AllDay
+		}
+		return day[i].Start.Before(day[j].Start)
+	})
+	return day
+}
+
+// timeSpan returns the part of the range from `start` to `end` covered by the event `e`, as fractions.
+func timeSpan(e *Event, start, end time.Time) (top, bottom float32) {
+	total := end.Sub(start)
+	from, to := e.Start, e.End
+	if from.Before(start) {
+		from = start
+	}
+	if to.After(end) {
+		to = end
+	}
+	if to.Before(from) {
+		to = from
+	}
+	return float32(from.Sub(start)) / float32(total), float32(to.Sub(start)) / float32(total)
+}
@@ -0,0 +1,239 @@
+package widget
+
+import (
+	"image/color"
+	"math"
+	"sort"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Declare conformity with the interfaces of the scheduler parts
+var _ fyne.Layout = (*timeGridLayout)(nil)
+var _ fyne.Draggable = (*schedulerEvent)(nil)
+var _ fyne.Tappable = (*schedulerEvent)(nil)
+
+// timeGridLayout lays out the timed events of the days of the week and day views, in a column per
+// day, from the top for the first hour shown to the bottom for the last one.
+type timeGridLayout struct {
+	days, hours int
+	gutter      float32
+
+	labels []*canvas.Text
+	lines  []*canvas.Line
+	events []*schedulerEvent
+}
+
+func newTimeGridLayout(days, hours int) *timeGridLayout {
+	size := fyne.MeasureText("00:00", theme.CaptionTextSize(), fyne.TextStyle{})
+	return &timeGridLayout{days: days, hours: hours, gutter: size.Width + 2*theme.Padding()}
+}
+
+// Layout is called to pack all child objects into a specified size.
+func (l *timeGridLayout) Layout(_ []fyne.CanvasObject, size fyne.Size) {
+	hourHeight := size.Height / float32(l.hours)
+	dayWidth := (size.Width - l.gutter) / float32(l.days)
+	for i, label := range l.labels {
+		label.Move(fyne.NewPos(theme.Padding(), float32(i)*hourHeight))
+		label.Resize(label.MinSize())
+		l.lines[i].Move(fyne.NewPos(l.gutter, float32(i)*hourHeight))
+		l.lines[i].Resize(fyne.NewSize(size.Width-l.gutter, 0))
+	}
+
+	minHeight := fyne.MeasureText("M", theme.CaptionTextSize(), fyne.TextStyle{}).Height
+	for _, e := range l.events {
+		width := dayWidth / float32(e.columns)
+		height := float32(math.Max(float64((e.bottom-e.top)*size.Height), float64(minHeight)))
+		e.Move(fyne.NewPos(l.gutter+float32(e.day)*dayWidth+float32(e.column)*width, e.top*size.Height))
+		e.Resize(fyne.NewSize(width-1, height))
+		e.dayWidth, e.minuteHeight = dayWidth, hourHeight/60
+	}
+}
+
+// MinSize finds the smallest size that satisfies all the child objects.
+func (l *timeGridLayout) MinSize(_ []fyne.CanvasObject) fyne.Size {
+	line := widget.NewLabel("22").MinSize()
+	return fyne.NewSize(l.gutter+line.Width*float32(l.days), line.Height*float32(l.hours))
+}
+
+// arrangeColumns sets the columns of the `events` of a day, so that overlapping events are side by
+// side, in as many columns as needed by the group of events overlapping each other.
+func arrangeColumns(events []*schedulerEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].top != events[j].top {
+			return events[i].top < events[j].top
+		}
+		return events[i].bottom > events[j].bottom
+	})
+
+	var group []*schedulerEvent
+	var columnEnds []float32
+	groupEnd := float32(-1)
+	closeGroup := func() {
+		for _, e := range group {
+			e.columns = len(columnEnds)
+		}
+		group, columnEnds = nil, nil
+	}
+
+	for _, e := range events {
+		if e.top >= groupEnd {
+			closeGroup()
+		}
+
+		bottom := e.bottom
+		if bottom <= e.top {
+			bottom = e.top + 1e-6 // events without duration still take a place
+		}
+		e.column = len(columnEnds)
+		for i, end := range columnEnds {
+			if end <= e.top {
+				e.column = i
+				break
+			}
+		}
+		if e.column == len(columnEnds) {
+			columnEnds = append(columnEnds, bottom)
+		} else {
+			columnEnds[e.column] = bottom
+		}
+
+		group = append(group, e)
+		if bottom > groupEnd {
+			groupEnd = bottom
+		}
+	}
+	closeGroup()
+}
+
+// importanceColor returns the color of the events of the `importance`.
+func importanceColor(importance widget.Importance) color.Color {
+	switch importance {
+	case widget.DangerImportance:
+		return theme.ErrorColor()
+	case widget.WarningImportance:
+		return theme.WarningColor()
+	case widget.SuccessImportance:
+		return theme.SuccessColor()
+	case widget.LowImportance:
+		return theme.DisabledColor()
+	}
+	return theme.PrimaryColor()
+}
+
+// schedulerEvent shows an event of a Scheduler, that can be dragged in the week and day views.
+type schedulerEvent struct {
+	widget.BaseWidget
+	scheduler *Scheduler
+	event     *Event
+	text      string
+
+	// timed events are laid out by time, in the column of their day.
+	timed                  bool
+	day, column, columns   int
+	top, bottom            float32
+	dayWidth, minuteHeight float32
+
+	dragging, resizing bool
+	dragged            fyne.Delta
+	origin             fyne.Position
+	size               fyne.Size
+}
+
+func newSchedulerEvent(s *Scheduler, e *Event, text string) *schedulerEvent {
+	item := &schedulerEvent{scheduler: s, event: e, text: text, columns: 1}
+	item.ExtendBaseWidget(item)
+	return item
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+func (e *schedulerEvent) CreateRenderer() fyne.WidgetRenderer {
+	stroke := importanceColor(e.event.Importance)
+	fill := color.NRGBAModel.Convert(stroke).(color.NRGBA)
+	fill.A = 0x40
+
+	background := canvas.NewRectangle(fill)
+	background.StrokeColor, background.StrokeWidth = stroke, 1
+	text := canvas.NewText(e.text, theme.ForegroundColor())
+	text.TextSize = theme.CaptionTextSize()
+	return widget.NewSimpleRenderer(container.NewStack(background, container.NewPadded(text)))
+}
+
+// DragEnd is called when the drag of the event ends, and calls OnEventMoved or OnEventResized.
+// The event goes back to its place if it snaps to the same time.
+//
+// Implements: fyne.Draggable
+func (e *schedulerEvent) DragEnd() {
+	if !e.dragging {
+		return
+	}
+	e.dragging = false
+
+	s := e.scheduler
+	snap := s.snap()
+	moved := time.Duration(float64(e.dragged.DY/e.minuteHeight) * float64(time.Minute)).Round(snap)
+	if e.resizing {
+		end := e.event.End.Add(moved)
+		if !end.After(e.event.Start) {
+			end = e.event.Start.Add(snap)
+		}
+		if end.Equal(e.event.End) {
+			e.Resize(e.size)
+			return
+		}
+		s.OnEventResized(e.event, end)
+	} else {
+		days := 0
+		if s.View == SchedulerWeek {
+			days = int(math.Round(float64(e.dragged.DX / e.dayWidth)))
+		}
+		start := e.event.Start.AddDate(0, 0, days).Add(moved)
+		if start.Equal(e.event.Start) {
+			e.Move(e.origin)
+			return
+		}
+		s.OnEventMoved(e.event, start, start.Add(e.event.End.Sub(e.event.Start)))
+	}
+	s.Refresh()
+}
+
+// Dragged is called while the event is dragged, and moves it or resizes it if its bottom is dragged.
+//
+// Implements: fyne.Draggable
+func (e *schedulerEvent) Dragged(ev *fyne.DragEvent) {
+	if !e.timed {
+		return
+	}
+
+	if !e.dragging {
+		start := ev.Position.Y - ev.Dragged.DY
+		e.resizing = start >= e.Size().Height-2*theme.Padding() && e.scheduler.OnEventResized != nil
+		if !e.resizing && e.scheduler.OnEventMoved == nil {
+			return
+		}
+		e.dragging, e.dragged = true, fyne.Delta{}
+		e.origin, e.size = e.Position(), e.Size()
+	}
+
+	e.dragged.DX += ev.Dragged.DX
+	e.dragged.DY += ev.Dragged.DY
+	if e.resizing {
+		e.Resize(fyne.NewSize(e.size.Width, float32(math.Max(float64(e.size.Height+e.dragged.DY), 1))))
+	} else {
+		e.Move(e.origin.Add(e.dragged))
+	}
+}
+
+// Tapped is called when the event is tapped, and calls OnEventTapped.
+//
+// Implements: fyne.Tappable
+func (e *schedulerEvent) Tapped(*fyne.PointEvent) {
+	if e.scheduler.OnEventTapped != nil {
+		e.scheduler.OnEventTapped(e.event)
+	}
+}
@@ -0,0 +1,150 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventList_Events(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	lunch := &Event{Title: "Lunch", Start: day.Add(12 * time.Hour), End: day.Add(13 * time.Hour)}
+	reminder := &Event{Title: "Reminder", Start: day.Add(9 * time.Hour), End: day.Add(9 * time.Hour)}
+	trip := &Event{Title: "Trip", Start: day.AddDate(0, 0, -2), End: day.AddDate(0, 0, 1), AllDay: true}
+	events := EventList{lunch, reminder, trip}
+
+	assert.Equal(t, []*Event{lunch, reminder, trip}, events.Events(day, day.AddDate(0, 0, 1)))
+	assert.Equal(t, []*Event{trip}, events.Events(day.AddDate(0, 0, -1), day))
+	assert.Empty(t, events.Events(day.AddDate(0, 0, 1), day.AddDate(0, 0, 2)))
+	assert.Equal(t, []*Event{reminder, lunch}, eventsOn(events, day)[1:])
+}
+
+func TestArrangeColumns(t *testing.T) {
+	slot := func(top, bottom float32) *schedulerEvent {
+		return &schedulerEvent{top: top, bottom: bottom, columns: 1}
+	}
+	a, b, c, d := slot(0.1, 0.3), slot(0.2, 0.5), slot(0.35, 0.4), slot(0.6, 0.7)
+	arrangeColumns([]*schedulerEvent{d, c, b, a})
+
+	assert.Equal(t, 0, a.column)
+	assert.Equal(t, 1, b.column)
+	assert.Equal(t, 0, c.column) // a has ended
+	assert.Equal(t, 2, a.columns)
+	assert.Equal(t, 2, c.columns)
+	assert.Equal(t, 0, d.column)
+	assert.Equal(t, 1, d.columns)
+}
+
+func TestScheduler_Navigation(t *testing.T) {
+	date := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+	s := NewScheduler(date, nil)
+	s.FirstWeekday = time.Monday
+	_ = test.WidgetRenderer(s)
+	assert.Equal(t, "January 2024", s.title.Text)
+
+	test.Tap(s.next)
+	assert.Equal(t, "February 2024", s.title.Text)
+	assert.Equal(t, 29, s.Date().Day())
+
+	s.SetView(SchedulerWeek)
+	assert.Equal(t, "26 Feb - 3 Mar 2024", s.title.Text)
+	assert.Equal(t, 1, s.views.SelectedIndex())
+	test.Tap(s.previous)
+	assert.Equal(t, "19 Feb - 25 Feb 2024", s.title.Text)
+
+	s.SetView(SchedulerDay)
+	assert.Equal(t, "Thursday 22 February 2024", s.title.Text)
+}
+
+func TestScheduler_Month(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	events := EventList{}
+	for h := 8; h < 13; h++ {
+		events = append(events, &Event{Title: "Meeting", Start: day.Add(time.Duration(h) * time.Hour),
+			End: day.Add(time.Duration(h)*time.Hour + 30*time.Minute)})
+	}
+	s := NewScheduler(day, events)
+	var tapped *Event
+	s.OnEventTapped = func(e *Event) { tapped = e }
+	_ = test.WidgetRenderer(s)
+
+	grid := s.body.Objects[0].(*fyne.Container)
+	_, ok := grid.Layout.(*calendarLayout)
+	assert.True(t, ok)
+	cell := grid.Objects[len(grid.Objects)-22].(*fyne.Container) // the 10th of 31 days
+	assert.Len(t, cell.Objects, 1+schedulerMonthEvents+1)
+	first := cell.Objects[1].(*schedulerEvent)
+	assert.Equal(t, "08:00 Meeting", first.text)
+	test.Tap(first)
+	assert.Equal(t, events[0], tapped)
+}
+
+func TestScheduler_Drag(t *testing.T) {
+	day := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	meeting := &Event{Title: "Meeting", Start: day.Add(9 * time.Hour), End: day.Add(10 * time.Hour)}
+	other := &Event{Title: "Call", Start: day.Add(9*time.Hour + 30*time.Minute), End: day.Add(11 * time.Hour)}
+	s := NewScheduler(day, EventList{meeting, other})
+	s.FirstWeekday = time.Monday
+	s.View = SchedulerWeek
+	s.StartHour, s.EndHour = 8, 18
+	var start, end time.Time
+	s.OnEventMoved = func(_ *Event, from, to time.Time) { start, end = from, to }
+	s.OnEventResized = func(_ *Event, to time.Time) { end = to }
+
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.Resize(fyne.NewSize(800, 600))
+
+	assert.Len(t, s.grid.events, 2)
+	e := s.grid.events[0]
+	assert.Equal(t, meeting, e.event)
+	assert.Equal(t, 2, e.columns)
+	assert.Less(t, e.Position().X, s.grid.events[1].Position().X)
+
+	e.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(5, 5)},
+		Dragged: fyne.NewDelta(e.dayWidth, 0)})
+	e.Dragged(&fyne.DragEvent{Dragged: fyne.NewDelta(0, e.minuteHeight*31)})
+	e.DragEnd()
+	assert.Equal(t, day.AddDate(0, 0, 1).Add(9*time.Hour+30*time.Minute), start)
+	assert.Equal(t, start.Add(time.Hour), end)
+
+	e = s.grid.events[0]
+	bottom := e.Size().Height - 1
+	e.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(5, bottom+e.minuteHeight*45)},
+		Dragged: fyne.NewDelta(0, e.minuteHeight*45)})
+	e.DragEnd()
+	assert.Equal(t, day.Add(10*time.Hour+45*time.Minute), end)
+}
+
+func TestScheduler_DragBack(t *testing.T) {
+	day := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	meeting := &Event{Title: "Meeting", Start: day.Add(9 * time.Hour), End: day.Add(10 * time.Hour)}
+	s := NewScheduler(day, EventList{meeting})
+	s.View = SchedulerDay
+	s.StartHour, s.EndHour = 8, 18
+	called := false
+	s.OnEventMoved = func(*Event, time.Time, time.Time) { called = true }
+	s.OnEventResized = func(*Event, time.Time) { called = true }
+
+	w := test.NewWindow(s)
+	defer w.Close()
+	w.Resize(fyne.NewSize(800, 600))
+
+	e := s.grid.events[0]
+	origin, size := e.Position(), e.Size()
+	e.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(5, 5)},
+		Dragged: fyne.NewDelta(0, e.minuteHeight*2)})
+	e.DragEnd()
+	assert.False(t, called)
+	assert.Equal(t, origin, e.Position())
+
+	bottom := size.Height - 1
+	e.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(5, bottom+e.minuteHeight*2)},
+		Dragged: fyne.NewDelta(0, e.minuteHeight*2)})
+	e.DragEnd()
+	assert.False(t, called)
+	assert.Equal(t, size, e.Size())
+}
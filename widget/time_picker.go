@@ -0,0 +1,179 @@
+package widget
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"fyne.io/x/fyne/lang"
+)
+
+const (
+	timeAM = "AM"
+	timePM = "PM"
+)
+
+// TimePicker is a widget to edit the hours, minutes and optionally the seconds of a time,
+// with a 24 or 12 hour clock, and the time zone among a list of locations.
+type TimePicker struct {
+	widget.BaseWidget
+
+	// ShowSeconds shows an entry for the seconds, that are kept unchanged otherwise.
+	ShowSeconds bool
+	// Use12Hour edits the hours from 1 to 12, with an AM/PM selector.
+	Use12Hour bool
+	// Locations are the time zones that can be selected. The time zone of the time is kept if empty.
+	Locations []*time.Location
+
+	// OnChanged is called each time the time is changed by the user.
+	OnChanged func(time.Time)
+
+	value    time.Time
+	updating bool
+
+	hour, minute, second *NumericalEntry
+	period, zone         *widget.Select
+}
+
+// NewTimePicker returns a time picker set to the time `t`, calling `changed` when it is changed by
+// the user. The date part of the time is kept unchanged.
+func NewTimePicker(t time.Time, changed func(time.Time)) *TimePicker {
+	p := &TimePicker{value: t, OnChanged: changed}
+	p.ExtendBaseWidget(p)
+	return p
+}
+
+// CreateRenderer is a private method to Fyne which links this widget to its renderer.
+//
+// Implements: fyne.Widget
+func (p *TimePicker) CreateRenderer() fyne.WidgetRenderer {
+	p.hour = p.newField(0, 23)
+	if p.Use12Hour {
+		p.hour.Min, p.hour.Max = 1, 12
+	}
+	p.minute = p.newField(0, 59)
+	objects := []fyne.CanvasObject{p.hour, widget.NewLabel(":"), p.minute}
+	if p.ShowSeconds {
+		p.second = p.newField(0, 59)
+		objects = append(objects, widget.NewLabel(":"), p.second)
+	}
+
+	if p.Use12Hour {
+		p.period = widget.NewSelect([]string{lang.Localize(timeAM), lang.Localize(timePM)}, func(string) {
+			p.update()
+		})
+		objects = append(objects, p.period)
+	}
+	if len(p.Locations) > 0 {
+		names := make([]string, len(p.Locations))
+		for i, l := range p.Locations {
+			names[i] = l.String()
+		}
+		p.zone = widget.NewSelect(names, func(string) {
+			p.update()
+		})
+		objects = append(objects, p.zone)
+	}
+
+	p.refreshFields()
+	return widget.NewSimpleRenderer(container.NewHBox(objects...))
+}
+
+// SetTime sets the time shown by the picker, without calling OnChanged.
+func (p *TimePicker) SetTime(t time.Time) {
+	p.value = t
+	p.refreshFields()
+}
+
+// Time returns the time set in the picker.
+func (p *TimePicker) Time() time.Time {
+	return p.value
+}
+
+func (p *TimePicker) newField(min, max float64) *NumericalEntry {
+	e := NewNumericalEntry()
+	e.Min, e.Max = min, max
+	e.ShowSpinner = true
+	e.OnChanged = func(string) {
+		p.update()
+	}
+	return e
+}
+
+// refreshFields shows the time in the fields of the picker, once created.
+func (p *TimePicker) refreshFields() {
+	if p.hour == nil {
+		return
+	}
+
+	p.updating = true
+	defer func() { p.updating = false }()
+
+	hour := p.value.Hour()
+	if p.period != nil {
+		if hour < 12 {
+			p.period.SetSelectedIndex(0)
+		} else {
+			p.period.SetSelectedIndex(1)
+		}
+		if hour = hour % 12; hour == 0 {
+			hour = 12
+		}
+	}
+	p.hour.SetValue(float64(hour))
+	p.minute.SetValue(float64(p.value.Minute()))
+	if p.second != nil {
+		p.second.SetValue(float64(p.value.Second()))
+	}
+	if p.zone != nil {
+		for i, l := range p.Locations {
+			if l.String() == p.value.Location().String() {
+				p.zone.SetSelectedIndex(i)
+			}
+		}
+	}
+}
+
+// update reads the time from the fields, when they hold valid values, and calls OnChanged.
+func (p *TimePicker) update() {
+	if p.updating {
+		return
+	}
+
+	hour, err := p.hour.Value()
+	if err != nil || hour < p.hour.Min || hour > p.hour.Max {
+		return
+	}
+	minute, err := p.minute.Value()
+	if err != nil || minute > 59 {
+		return
+	}
+	second := float64(p.value.Second())
+	if p.second != nil {
+		if second, err = p.second.Value(); err != nil || second > 59 {
+			return
+		}
+	}
+	if p.period != nil {
+		hour = float64(int(hour) % 12)
+		if p.period.SelectedIndex() == 1 {
+			hour += 12
+		}
+	}
+	location := p.value.Location()
+	if p.zone != nil && p.zone.SelectedIndex() >= 0 {
+		location = p.Locations[p.zone.SelectedIndex()]
+	}
+
+	t := time.Date(p.value.Year(), p.value.Month(), p.value.Day(), int(hour), int(minute), int(second),
+		p.value.Nanosecond(), location)
+	if t.Equal(p.value) && t.Location() == p.value.Location() {
+		return
+	}
+	p.value = t
+	if p.OnChanged != nil {
+		p.OnChanged(t)
+	}
+}
@@ -0,0 +1,51 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimePicker(t *testing.T) {
+	var changed time.Time
+	p := NewTimePicker(time.Date(2024, time.March, 10, 14, 30, 15, 0, time.UTC), func(t time.Time) {
+		changed = t
+	})
+	_ = test.WidgetRenderer(p)
+	assert.Equal(t, "14", p.hour.Text)
+	assert.Equal(t, "30", p.minute.Text)
+	assert.Nil(t, p.second)
+
+	p.minute.SetText("45")
+	assert.Equal(t, time.Date(2024, time.March, 10, 14, 45, 15, 0, time.UTC), changed)
+	p.hour.SetText("24")
+	assert.Equal(t, 14, p.Time().Hour())
+
+	p.SetTime(time.Date(2024, time.March, 11, 9, 5, 0, 0, time.UTC))
+	assert.Equal(t, "9", p.hour.Text)
+	assert.Equal(t, 14, changed.Hour())
+}
+
+func TestTimePicker_12Hour(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	p := NewTimePicker(time.Date(2024, time.March, 10, 0, 30, 0, 0, time.UTC), nil)
+	p.Use12Hour = true
+	p.ShowSeconds = true
+	p.Locations = []*time.Location{time.UTC, paris}
+	_ = test.WidgetRenderer(p)
+	assert.Equal(t, "12", p.hour.Text)
+	assert.Equal(t, 0, p.period.SelectedIndex())
+	assert.Equal(t, "UTC", p.zone.Selected)
+
+	p.period.SetSelectedIndex(1)
+	assert.Equal(t, 12, p.Time().Hour())
+	p.second.SetText("20")
+	p.zone.SetSelected("Europe/Paris")
+	assert.Equal(t, time.Date(2024, time.March, 10, 12, 30, 20, 0, paris), p.Time())
+}